@@ -1,14 +1,24 @@
 """Language adapter interfaces."""
 
 from .base import (
+    SYMBOL_ID_LENGTH,
     AdapterContractError,
+    FileImport,
     LanguageAdapter,
     OutlineSymbol,
+    ParseDiagnostic,
     SymbolReference,
+    file_import_payload,
+    import_sort_key,
+    normalize_and_sort_diagnostics,
+    normalize_and_sort_imports,
     normalize_and_sort_references,
     normalize_and_sort_symbols,
     normalize_signature,
+    outline_symbol_from_payload,
+    outline_symbol_payload,
     reference_sort_key,
+    symbol_id,
     symbol_sort_key,
     validate_outline_symbols,
     validate_symbol_references,
@@ -18,11 +28,13 @@ from .csharp import CSharpLexicalAdapter
 from .fallback import LexicalFallbackAdapter
 from .go import GoLexicalAdapter
 from .java import JavaLexicalAdapter
+from .kotlin import KotlinLexicalAdapter
 from .lexical import (
     BraceBlock,
     BraceScanResult,
     LexicalRules,
     LexicalToken,
+    brace_balance_diagnostics,
     extract_identifier_tokens,
     mask_comments_and_strings,
     scan_brace_blocks,
@@ -34,31 +46,43 @@ from .rust import RustLexicalAdapter
 from .ts_js import TypeScriptJavaScriptLexicalAdapter
 
 __all__ = [
+    "SYMBOL_ID_LENGTH",
     "AdapterRegistry",
     "AdapterContractError",
     "CppLexicalAdapter",
     "CSharpLexicalAdapter",
+    "FileImport",
     "LanguageAdapter",
     "LexicalFallbackAdapter",
     "GoLexicalAdapter",
     "JavaLexicalAdapter",
+    "KotlinLexicalAdapter",
     "LexicalRules",
     "LexicalToken",
     "OutlineSymbol",
+    "ParseDiagnostic",
     "SymbolReference",
     "PythonAstAdapter",
     "RustLexicalAdapter",
     "TypeScriptJavaScriptLexicalAdapter",
     "BraceBlock",
     "BraceScanResult",
+    "brace_balance_diagnostics",
     "build_adapter_registry",
     "extract_identifier_tokens",
+    "file_import_payload",
+    "import_sort_key",
     "mask_comments_and_strings",
+    "normalize_and_sort_diagnostics",
+    "normalize_and_sort_imports",
     "normalize_and_sort_references",
     "normalize_and_sort_symbols",
     "normalize_signature",
+    "outline_symbol_from_payload",
+    "outline_symbol_payload",
     "reference_sort_key",
     "scan_brace_blocks",
+    "symbol_id",
     "symbol_sort_key",
     "validate_symbol_references",
     "validate_outline_symbols",
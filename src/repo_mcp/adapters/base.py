@@ -2,9 +2,14 @@
 
 from __future__ import annotations
 
-from dataclasses import dataclass, replace
+import hashlib
+import re
+from dataclasses import asdict, dataclass, fields, replace
 from typing import Protocol
 
+_NAME_SEPARATOR_RE = re.compile(r"\.|::")
+_IDENTIFIER_BOUNDARY_TEMPLATE = r"(?<![A-Za-z0-9_$]){name}(?![A-Za-z0-9_$])"
+
 
 @dataclass(slots=True, frozen=True)
 class OutlineSymbol:
@@ -20,6 +25,74 @@ class OutlineSymbol:
     scope_kind: str | None = None
     is_conditional: bool | None = None
     decl_context: str | None = None
+    decorators: tuple[str, ...] | None = None
+    visibility: str | None = None
+    start_col: int | None = None
+    tag: str | None = None
+    tags: tuple[tuple[str, str], ...] | None = None
+    calls: tuple[str, ...] | None = None
+    returns: tuple[str, ...] | None = None
+    is_test: bool | None = None
+    role: str | None = None
+    implements: tuple[str, ...] | None = None
+    package: str | None = None
+    qualified_name: str | None = None
+    deprecated: bool | None = None
+    deprecation_note: str | None = None
+    value: str | None = None
+    value_type: str | None = None
+    iota_value: int | None = None
+    id: str | None = None
+    access: str | None = None
+    build_constraints: tuple[str, ...] | None = None
+    spawns_goroutine: bool | None = None
+    uses_channels: bool | None = None
+    takes_context: bool | None = None
+    accessors: tuple[str, ...] | None = None
+    partial: bool | None = None
+    receiver: str | None = None
+    receiver_name: str | None = None
+    references: tuple[str, ...] | None = None
+    complexity: int | None = None
+    line_count: int | None = None
+    examples: tuple[str, ...] | None = None
+    canonical_signature: str | None = None
+
+
+SYMBOL_ID_LENGTH = 16
+_SYMBOL_ID_SEPARATOR = "\x1f"
+_MAPPING_FIELDS = frozenset({"tags"})
+
+
+@dataclass(slots=True, frozen=True)
+class ParseDiagnostic:
+    """Problem an adapter found while parsing a file.
+
+    `line` is the 1-based line the problem was detected on, or `None` when
+    it applies to the whole file. Symbols outside the problem area are still
+    reported by `outline`.
+    """
+
+    line: int | None
+    message: str
+
+
+@dataclass(slots=True, frozen=True)
+class FileImport:
+    """Single import declared by a source file.
+
+    `path` is the imported module or package as written. `name` is the member
+    imported from it (`None` for whole-module imports), and `alias` is the
+    local name chosen by the importer, when any. `used` reports whether the
+    bound name is referenced elsewhere in the file, or `None` when that cannot
+    be determined (side-effect, wildcard, or dot imports).
+    """
+
+    path: str
+    name: str | None
+    alias: str | None
+    line: int
+    used: bool | None
 
 
 @dataclass(slots=True, frozen=True)
@@ -67,6 +140,7 @@ def symbol_sort_key(symbol: OutlineSymbol) -> tuple[int, int, str, str]:
 def validate_outline_symbols(symbols: list[OutlineSymbol]) -> None:
     """Validate symbols against required invariant fields."""
     allowed_scope_kinds = {"module", "class", "function"}
+    allowed_visibility = {"public", "private"}
     for symbol in symbols:
         if not symbol.kind.strip():
             raise AdapterContractError("Outline symbol kind must be non-empty.")
@@ -76,10 +150,150 @@ def validate_outline_symbols(symbols: list[OutlineSymbol]) -> None:
             raise AdapterContractError("Outline symbol start_line must be >= 1.")
         if symbol.end_line < symbol.start_line:
             raise AdapterContractError("Outline symbol end_line must be >= start_line.")
+        if symbol.start_col is not None and symbol.start_col < 1:
+            raise AdapterContractError("Outline symbol start_col must be >= 1.")
         if symbol.scope_kind is not None and symbol.scope_kind not in allowed_scope_kinds:
             raise AdapterContractError(
                 "Outline symbol scope_kind must be one of module, class, function."
             )
+        if symbol.visibility is not None and symbol.visibility not in allowed_visibility:
+            raise AdapterContractError("Outline symbol visibility must be public or private.")
+
+
+def assign_start_columns(symbols: list[OutlineSymbol], text: str) -> list[OutlineSymbol]:
+    """Fill missing start_col values with the 1-based column of each symbol's name.
+
+    The unqualified name is located as a whole identifier on start_line; when it
+    does not appear there, the first non-whitespace column of that line is used.
+    Symbols that already carry a start_col are returned unchanged.
+    """
+    lines = text.splitlines()
+    output: list[OutlineSymbol] = []
+    for symbol in symbols:
+        if symbol.start_col is not None or symbol.start_line > len(lines):
+            output.append(symbol)
+            continue
+        line = lines[symbol.start_line - 1]
+        output.append(replace(symbol, start_col=name_column(line, symbol.name)))
+    return output
+
+
+def assign_package(
+    symbols: list[OutlineSymbol],
+    package: str | None,
+    *,
+    prefix_names: bool = True,
+) -> list[OutlineSymbol]:
+    """Fill missing `package` and `qualified_name` values.
+
+    Symbols that already carry a package keep it. With prefix_names the
+    qualified name is `<package>.<name>`; adapters whose names already start
+    with the package (Go, Java, C#) pass False and keep `name` as is. Without a
+    package the qualified name equals `name`.
+    """
+    output: list[OutlineSymbol] = []
+    for symbol in symbols:
+        symbol_package = symbol.package if symbol.package is not None else package
+        qualified_name = symbol.qualified_name
+        if qualified_name is None:
+            qualified_name = (
+                f"{symbol_package}.{symbol.name}"
+                if prefix_names and symbol_package is not None
+                else symbol.name
+            )
+        output.append(replace(symbol, package=symbol_package, qualified_name=qualified_name))
+    return output
+
+
+def symbol_id(kind: str, package: str | None, qualified_name: str) -> str:
+    """Return the stable ID of a symbol with this kind, package, and qualified name.
+
+    The ID is the first `SYMBOL_ID_LENGTH` lowercase hex digits of the SHA-256
+    of `kind`, `package` (empty when None), and `qualified_name` joined with
+    the unit separator U+001F and encoded as UTF-8. Line numbers are left out
+    so moving a declaration keeps its ID.
+    """
+    key = _SYMBOL_ID_SEPARATOR.join((kind, package or "", qualified_name))
+    return hashlib.sha256(key.encode("utf-8")).hexdigest()[:SYMBOL_ID_LENGTH]
+
+
+def mark_deprecation(symbol: OutlineSymbol, note: str | None) -> OutlineSymbol:
+    """Return symbol with `deprecated` set from a detected note.
+
+    note is None when the adapter found no deprecation marker; an empty note
+    marks the symbol deprecated without a `deprecation_note`.
+    """
+    return replace(symbol, deprecated=note is not None, deprecation_note=note or None)
+
+
+def name_column(line: str, name: str, start: int = 0) -> int:
+    """Return the 1-based column of name's last segment in line at or after start."""
+    short_name = _NAME_SEPARATOR_RE.split(name)[-1]
+    boundary = _IDENTIFIER_BOUNDARY_TEMPLATE.format(name=re.escape(short_name))
+    match = re.compile(boundary).search(line, start) if short_name else None
+    if match is not None:
+        return match.start() + 1
+    return len(line) - len(line.lstrip()) + 1
+
+
+def outline_symbol_payload(symbol: OutlineSymbol) -> dict[str, object]:
+    """Return a JSON-ready payload with every outline field.
+
+    Tuples are rendered as lists, except key/value pair fields such as `tags`,
+    which are rendered as objects.
+    """
+    payload: dict[str, object] = {}
+    for field in fields(symbol):
+        value = getattr(symbol, field.name)
+        if field.name in _MAPPING_FIELDS and value is not None:
+            payload[field.name] = dict(value)
+        else:
+            payload[field.name] = list(value) if isinstance(value, tuple) else value
+    return payload
+
+
+def outline_symbol_from_payload(payload: dict[str, object]) -> OutlineSymbol:
+    """Rebuild an outline symbol from outline_symbol_payload output.
+
+    Unknown keys are ignored and missing optional fields keep their defaults,
+    so payloads persisted by older releases still load.
+    """
+    values: dict[str, object] = {}
+    for field in fields(OutlineSymbol):
+        if field.name not in payload:
+            continue
+        value = payload[field.name]
+        if isinstance(value, dict):
+            values[field.name] = tuple((str(key), str(item)) for key, item in value.items())
+        else:
+            values[field.name] = tuple(value) if isinstance(value, list) else value
+    return OutlineSymbol(**values)  # type: ignore[arg-type]
+
+
+def file_import_payload(record: FileImport) -> dict[str, object]:
+    """Return a JSON-ready payload for one file import."""
+    return asdict(record)
+
+
+def normalize_and_sort_diagnostics(records: list[ParseDiagnostic]) -> list[ParseDiagnostic]:
+    """Drop exact duplicates and sort by line, whole-file problems first, then message."""
+    unique = set(records)
+    return sorted(unique, key=lambda item: (item.line or 0, item.message))
+
+
+def import_sort_key(record: FileImport) -> tuple[int, str, str, str]:
+    """Return deterministic sort key for file imports."""
+    return (record.line, record.path, record.name or "", record.alias or "")
+
+
+def normalize_and_sort_imports(records: list[FileImport]) -> list[FileImport]:
+    """Validate import invariants, drop exact duplicates, and sort deterministically."""
+    for record in records:
+        if not record.path.strip():
+            raise AdapterContractError("File import path must be non-empty.")
+        if record.line < 1:
+            raise AdapterContractError("File import line must be >= 1.")
+    return sorted(set(records), key=import_sort_key)
 
 
 def reference_sort_key(reference: SymbolReference) -> tuple[str, int, str, str]:
@@ -167,6 +381,8 @@ def _normalize_symbol(symbol: OutlineSymbol) -> OutlineSymbol:
         parent_symbol=inferred_parent,
         scope_kind=inferred_scope_kind,
         decl_context=normalize_optional_text(symbol.decl_context),
+        id=symbol.id
+        or symbol_id(symbol.kind, symbol.package, symbol.qualified_name or symbol.name),
     )
 
 
@@ -201,3 +417,9 @@ class LanguageAdapter(Protocol):
 
     def symbol_hints(self, prompt: str) -> tuple[str, ...]:
         """Optionally return deterministic symbol hints from prompt text."""
+
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """Optionally return the file's imports; None when the adapter does not extract them."""
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Optionally return parse problems; None when the adapter does not check for them."""
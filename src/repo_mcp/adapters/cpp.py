@@ -3,10 +3,20 @@
 from __future__ import annotations
 
 import re
-from dataclasses import dataclass
+from dataclasses import dataclass, replace
 
-from repo_mcp.adapters.base import OutlineSymbol, SymbolReference, normalize_and_sort_symbols
+from repo_mcp.adapters.base import (
+    FileImport,
+    OutlineSymbol,
+    ParseDiagnostic,
+    SymbolReference,
+    assign_package,
+    assign_start_columns,
+    normalize_and_sort_diagnostics,
+    normalize_and_sort_symbols,
+)
 from repo_mcp.adapters.lexical import (
+    brace_balance_diagnostics,
     mask_comments_and_strings,
     references_for_symbol_lexical,
     references_for_symbols_lexical,
@@ -31,11 +41,13 @@ _METHOD_RE = re.compile(
     r"([A-Za-z_~][A-Za-z0-9_]*)\s*\(([^;{}()]*)\)\s*"
     r"(?:const\s*)?(?:noexcept(?:\([^)]*\))?\s*)?(?:->\s*[^;{]+)?\s*([;{])"
 )
+_ACCESS_SECTION_RE = re.compile(r"^\s*(public|protected|private)\s*:(?!:)")
 _SKIP_NAMES = {"if", "for", "while", "switch", "catch", "return", "sizeof"}
 
 
 @dataclass(slots=True, frozen=True)
 class _TypeBlock:
+    kind: str
     name: str
     start_line: int
     end_line: int
@@ -54,7 +66,13 @@ class CppLexicalAdapter:
         return lowered.endswith(self._extensions)
 
     def outline(self, path: str, text: str) -> list[OutlineSymbol]:
-        """Extract namespace/type/function/method symbols deterministically."""
+        """Extract namespace/type/function/method symbols deterministically.
+
+        Namespace-scope declarations are `public`. Methods take `access` from
+        the enclosing `public:`, `protected:`, or `private:` section, defaulting
+        to `private` in a class and `public` in a struct, and are `public` only
+        when that access is.
+        """
         _ = path
         masked = mask_comments_and_strings(text)
         lines = masked.splitlines()
@@ -81,6 +99,7 @@ class CppLexicalAdapter:
                         start_line=line_number,
                         end_line=max(line_number, block_ends.get(line_number, line_number)),
                         doc=None,
+                        visibility="public",
                     )
                 )
                 continue
@@ -97,10 +116,12 @@ class CppLexicalAdapter:
                         start_line=line_number,
                         end_line=end_line,
                         doc=None,
+                        visibility="public",
                     )
                 )
                 type_blocks.append(
                     _TypeBlock(
+                        kind=kind,
                         name=name,
                         start_line=line_number,
                         end_line=end_line,
@@ -119,6 +140,7 @@ class CppLexicalAdapter:
                         start_line=line_number,
                         end_line=max(line_number, block_ends.get(line_number, line_number)),
                         doc=None,
+                        visibility="public",
                     )
                 )
                 continue
@@ -126,7 +148,7 @@ class CppLexicalAdapter:
             function_match = _FREE_FUNCTION_RE.match(line)
             if function_match is not None:
                 name, params, terminator = function_match.groups()
-                if name in _SKIP_NAMES:
+                if name in _SKIP_NAMES or _inside_type_block(type_blocks, line_number):
                     continue
                 end_line = (
                     max(line_number, block_ends.get(line_number, line_number))
@@ -141,6 +163,7 @@ class CppLexicalAdapter:
                         start_line=line_number,
                         end_line=end_line,
                         doc=None,
+                        visibility="public",
                     )
                 )
 
@@ -154,7 +177,9 @@ class CppLexicalAdapter:
                 )
             )
 
-        return normalize_and_sort_symbols(symbols)
+        return normalize_and_sort_symbols(
+            assign_package(_namespace_packages(assign_start_columns(symbols, text)), None)
+        )
 
     def smart_chunks(self, path: str, text: str) -> list[tuple[int, int]] | None:
         """C++ adapter does not provide smart chunk ranges in v1."""
@@ -167,6 +192,18 @@ class CppLexicalAdapter:
         _ = prompt
         return ()
 
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """C++ adapter does not extract imports in v1."""
+        _ = path
+        _ = text
+        return None
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Report unbalanced braces outside comments and strings."""
+        _ = path
+        masked = mask_comments_and_strings(text)
+        return normalize_and_sort_diagnostics(brace_balance_diagnostics(masked))
+
     def references_for_symbol(
         self,
         symbol: str,
@@ -198,6 +235,10 @@ class CppLexicalAdapter:
         )
 
 
+def _inside_type_block(type_blocks: list[_TypeBlock], line_number: int) -> bool:
+    return any(block.start_line < line_number <= block.end_line for block in type_blocks)
+
+
 def _line_depths(masked_text: str) -> list[int]:
     depths: list[int] = []
     depth = 0
@@ -227,12 +268,16 @@ def _extract_type_methods(
     type_block: _TypeBlock,
 ) -> list[OutlineSymbol]:
     symbols: list[OutlineSymbol] = []
+    access = "private" if type_block.kind == "class" else "public"
     start = max(type_block.start_line + 1, 1)
     end = min(type_block.end_line, len(lines))
     for line_number in range(start, end + 1):
         if depth_before[line_number - 1] != type_block.depth:
             continue
         line = lines[line_number - 1]
+        section = _ACCESS_SECTION_RE.match(line)
+        if section is not None:
+            access = section.group(1)
         matched = _METHOD_RE.match(line)
         if matched is None:
             continue
@@ -252,6 +297,26 @@ def _extract_type_methods(
                 start_line=line_number,
                 end_line=symbol_end,
                 doc=None,
+                visibility="public" if access == "public" else "private",
+                access=access,
             )
         )
     return symbols
+
+
+def _namespace_packages(symbols: list[OutlineSymbol]) -> list[OutlineSymbol]:
+    """Set each symbol's package to its enclosing namespaces joined with `.`."""
+    namespaces = sorted(
+        (symbol for symbol in symbols if symbol.kind == "namespace"),
+        key=lambda item: (item.start_line, -item.end_line),
+    )
+    output: list[OutlineSymbol] = []
+    for symbol in symbols:
+        enclosing = [
+            namespace.name.replace("::", ".")
+            for namespace in namespaces
+            if namespace is not symbol
+            and namespace.start_line <= symbol.start_line <= namespace.end_line
+        ]
+        output.append(replace(symbol, package=".".join(enclosing) if enclosing else None))
+    return output
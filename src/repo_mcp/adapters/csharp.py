@@ -3,41 +3,95 @@
 from __future__ import annotations
 
 import re
-from dataclasses import dataclass
-
-from repo_mcp.adapters.base import OutlineSymbol, SymbolReference, normalize_and_sort_symbols
+from dataclasses import dataclass, replace
+
+from repo_mcp.adapters.base import (
+    FileImport,
+    OutlineSymbol,
+    ParseDiagnostic,
+    SymbolReference,
+    assign_package,
+    assign_start_columns,
+    mark_deprecation,
+    normalize_and_sort_diagnostics,
+    normalize_and_sort_symbols,
+)
 from repo_mcp.adapters.lexical import (
+    brace_balance_diagnostics,
     mask_comments_and_strings,
     references_for_symbol_lexical,
     references_for_symbols_lexical,
     scan_brace_blocks,
 )
 
+_ATTRIBUTE = r"\[(?!\s*(?:assembly|module)\s*:)[^\[\]]*\]"
+_ATTRIBUTE_RE = re.compile(_ATTRIBUTE)
+_ATTRIBUTE_LINE_RE = re.compile(rf"^\s*(?:{_ATTRIBUTE}\s*)+$")
+_ATTRIBUTES_PREFIX = rf"(?P<attributes>(?:{_ATTRIBUTE}\s*)*)"
+_ATTRIBUTE_PREFIX_RE = re.compile(rf"^\s*{_ATTRIBUTES_PREFIX}")
 _NAMESPACE_RE = re.compile(r"^\s*namespace\s+([A-Za-z_][A-Za-z0-9_.]*)\b")
 _TYPE_RE = re.compile(
-    r"^\s*(?:(?:public|private|protected|internal|abstract|sealed|static|partial)\s+)*"
-    r"(class|struct|interface|enum|record)\s+([A-Za-z_][A-Za-z0-9_]*)\b"
+    rf"^\s*{_ATTRIBUTES_PREFIX}"
+    r"(?P<modifiers>(?:(?:public|private|protected|internal|file|abstract|sealed|static|partial|"
+    r"readonly|ref|unsafe|new)\s+)*)"
+    r"(?P<kind>class|struct|interface|enum|record)(?:\s+(?:class|struct))?\s+"
+    r"(?P<name>[A-Za-z_][A-Za-z0-9_]*)\b"
+)
+_MEMBER_MODIFIERS = (
+    r"(?P<modifiers>(?:(?:public|private|protected|internal|static|virtual|override|abstract|"
+    r"async|sealed|new|partial|extern|unsafe|readonly|required|volatile|const)\s+)*)"
 )
 _METHOD_RE = re.compile(
-    r"^\s*(?:(?:public|private|protected|internal|static|virtual|override|abstract|async|sealed|new)\s+)*"
+    rf"^\s*{_ATTRIBUTES_PREFIX}{_MEMBER_MODIFIERS}"
     r"(?:[A-Za-z_][A-Za-z0-9_<>\[\],?.\s]*\s+)?"
-    r"([A-Za-z_][A-Za-z0-9_]*)\s*\(([^)]*)\)\s*((?:=>|[;{]))?"
+    r"(?P<name>[A-Za-z_][A-Za-z0-9_]*)\s*(?:<[^<>()]*>\s*)?\((?P<params>[^)]*)\)\s*"
+    r"(?P<terminator>=>|[;{])?"
 )
 _PROPERTY_RE = re.compile(
-    r"^\s*(?:(?:public|private|protected|internal|static|virtual|override|abstract)\s+)*"
-    r"[A-Za-z_][A-Za-z0-9_<>\[\],?.\s]*\s+"
-    r"([A-Za-z_][A-Za-z0-9_]*)\s*\{\s*(?:get|set|init)\b"
+    rf"^\s*{_ATTRIBUTES_PREFIX}{_MEMBER_MODIFIERS}"
+    r"(?P<type>[A-Za-z_][A-Za-z0-9_<>\[\],?.\s]*?)\s+"
+    r"(?P<name>[A-Za-z_][A-Za-z0-9_]*)\s*(?P<body>\{|=>|$)"
 )
 _EVENT_RE = re.compile(
-    r"^\s*(?:(?:public|private|protected|internal|static)\s+)*event\s+"
-    r"[A-Za-z_][A-Za-z0-9_<>\[\],?.\s]*\s+([A-Za-z_][A-Za-z0-9_]*)\s*;"
+    rf"^\s*{_ATTRIBUTES_PREFIX}{_MEMBER_MODIFIERS}event\s+"
+    r"(?P<type>[A-Za-z_][A-Za-z0-9_<>\[\],?.\s]*?)\s+(?P<name>[A-Za-z_][A-Za-z0-9_]*)\s*;"
+)
+_FIELD_RE = re.compile(
+    rf"^\s*{_ATTRIBUTES_PREFIX}{_MEMBER_MODIFIERS}"
+    r"(?P<type>[A-Za-z_][A-Za-z0-9_.]*(?:\s*<[A-Za-z0-9_<>\[\], ?.]*>)?\??(?:\s*\[[\s,]*\])*\??)"
+    r"\s+(?P<name>[A-Za-z_][A-Za-z0-9_]*)\s*[=;,]"
+)
+_ACCESSOR_RE = re.compile(
+    rf"(?:^|[;}}])\s*(?:{_ATTRIBUTE}\s*)*"
+    r"(?P<accessor>(?:(?:public|private|protected|internal)\s+)*(?:get|set|init))(?![A-Za-z0-9_])"
+)
+_DECLARATOR_NAME_RE = re.compile(r"^\s*([A-Za-z_][A-Za-z0-9_]*)")
+_OBSOLETE_NOTE_RE = re.compile(r'^\(\s*@?"((?:[^"\\]|\\.)*)"')
+_METHOD_SKIP = frozenset(
+    {"if", "for", "foreach", "while", "switch", "catch", "using", "lock", "return", "new"}
 )
-_METHOD_SKIP = {"if", "for", "while", "switch", "catch", "return", "new"}
+_MEMBER_SKIP_TYPES = frozenset(
+    {"return", "using", "var", "goto", "yield", "await", "throw", "case", "new"}
+)
+_OBSOLETE_ATTRIBUTES = frozenset(
+    {"Obsolete", "ObsoleteAttribute", "System.Obsolete", "System.ObsoleteAttribute"}
+)
+_IMPLICITLY_PUBLIC_OWNERS = frozenset({"interface"})
+
+
+@dataclass(slots=True, frozen=True)
+class _NamespaceBlock:
+    name: str
+    start_line: int
+    end_line: int
+    depth: int
 
 
 @dataclass(slots=True, frozen=True)
 class _TypeBlock:
     name: str
+    simple_name: str
+    kind: str
     start_line: int
     end_line: int
     member_depth: int
@@ -53,46 +107,77 @@ class CSharpLexicalAdapter:
         return path.lower().endswith(".cs")
 
     def outline(self, path: str, text: str) -> list[OutlineSymbol]:
-        """Extract namespace, type, method, property, and event symbols."""
+        """Extract namespaces, nested types, and their methods, properties, fields, and events.
+
+        Access modifiers, with C#'s implicit defaults, populate `access` and
+        `visibility`; attributes populate `decorators`, and `[Obsolete]` marks
+        the symbol deprecated.
+        """
         _ = path
         masked = mask_comments_and_strings(text)
         lines = masked.splitlines()
+        raw_lines = text.splitlines()
         depth_before = _line_depths(masked)
         block_ends = _block_end_by_start_line(masked)
 
         symbols: list[OutlineSymbol] = []
+        namespaces: list[_NamespaceBlock] = []
         type_blocks: list[_TypeBlock] = []
-        current_namespace_depth: int | None = None
-        current_namespace: str | None = None
 
         for index, line in enumerate(lines):
             line_number = index + 1
-            if depth_before[index] != 0:
-                continue
+            depth = depth_before[index]
 
             namespace_match = _NAMESPACE_RE.match(line)
             if namespace_match is not None:
-                current_namespace = namespace_match.group(1)
-                current_namespace_depth = depth_before[index] + (1 if "{" in line else 0)
-                symbols.append(
-                    OutlineSymbol(
-                        kind="namespace",
-                        name=current_namespace,
-                        signature=None,
+                outer = _enclosing_namespace(namespaces, line_number, depth)
+                if depth != 0 and outer is None:
+                    continue
+                namespace_name = namespace_match.group(1)
+                if outer is not None:
+                    namespace_name = f"{outer.name}.{namespace_name}"
+                namespace_end = _declaration_end(line_number, line, block_ends)
+                file_scoped = namespace_end == line_number
+                namespaces.append(
+                    _NamespaceBlock(
+                        name=namespace_name,
                         start_line=line_number,
-                        end_line=_declaration_end(line_number, line, block_ends),
-                        doc=None,
+                        end_line=len(lines) if file_scoped else namespace_end,
+                        depth=depth if file_scoped else depth + 1,
+                    )
+                )
+                symbols.append(
+                    _with_attributes(
+                        OutlineSymbol(
+                            kind="namespace",
+                            name=namespace_name,
+                            signature=None,
+                            start_line=line_number,
+                            end_line=namespace_end,
+                            doc=None,
+                            visibility="public",
+                        ),
+                        (),
                     )
                 )
                 continue
 
             type_match = _TYPE_RE.match(line)
-            if type_match is not None:
-                kind, type_name = type_match.groups()
-                qualified = _qualify(current_namespace, type_name)
-                type_end = _declaration_end(line_number, line, block_ends)
-                namespace_depth = current_namespace_depth or 0
-                symbols.append(
+            if type_match is None:
+                continue
+            owner = _enclosing_type(type_blocks, line_number, depth)
+            namespace = _enclosing_namespace(namespaces, line_number, depth)
+            if owner is None and namespace is None and depth != 0:
+                continue
+            kind, type_name = type_match.group("kind"), type_match.group("name")
+            if owner is not None:
+                qualified = f"{owner.name}.{type_name}"
+            else:
+                qualified = _qualify(namespace.name if namespace is not None else None, type_name)
+            type_end = _declaration_end(line_number, line, block_ends)
+            access = _csharp_access(type_match.group("modifiers"), owner)
+            symbols.append(
+                _with_attributes(
                     OutlineSymbol(
                         kind=kind,
                         name=qualified,
@@ -100,28 +185,49 @@ class CSharpLexicalAdapter:
                         start_line=line_number,
                         end_line=type_end,
                         doc=None,
-                    )
+                        parent_symbol=owner.name if owner is not None else None,
+                        scope_kind="class" if owner is not None else None,
+                        visibility=_csharp_visibility(access),
+                        access=access,
+                        partial="partial" in type_match.group("modifiers").split(),
+                    ),
+                    _attributes(raw_lines, lines, index),
                 )
-                type_blocks.append(
-                    _TypeBlock(
-                        name=qualified,
-                        start_line=line_number,
-                        end_line=type_end,
-                        member_depth=namespace_depth + 1,
-                    )
+            )
+            type_blocks.append(
+                _TypeBlock(
+                    name=qualified,
+                    simple_name=type_name,
+                    kind=kind,
+                    start_line=line_number,
+                    end_line=type_end,
+                    member_depth=depth + 1,
                 )
+            )
 
+        line_offsets = _line_offsets(masked)
+        paren_before = _paren_depths(lines)
         for type_block in type_blocks:
             symbols.extend(
                 _extract_type_members(
+                    masked=masked,
                     lines=lines,
+                    raw_lines=raw_lines,
+                    line_offsets=line_offsets,
                     depth_before=depth_before,
+                    paren_before=paren_before,
                     block_ends=block_ends,
                     type_block=type_block,
                 )
             )
 
-        return normalize_and_sort_symbols(symbols)
+        return normalize_and_sort_symbols(
+            assign_package(
+                _namespace_packages(assign_start_columns(symbols, text)),
+                None,
+                prefix_names=False,
+            )
+        )
 
     def smart_chunks(self, path: str, text: str) -> list[tuple[int, int]] | None:
         """C# adapter does not provide smart chunk ranges in v1."""
@@ -134,6 +240,18 @@ class CSharpLexicalAdapter:
         _ = prompt
         return ()
 
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """C# adapter does not extract imports in v1."""
+        _ = path
+        _ = text
+        return None
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Report unbalanced braces outside comments and strings."""
+        _ = path
+        masked = mask_comments_and_strings(text)
+        return normalize_and_sort_diagnostics(brace_balance_diagnostics(masked))
+
     def references_for_symbol(
         self,
         symbol: str,
@@ -187,75 +305,351 @@ def _block_end_by_start_line(masked_text: str) -> dict[int, int]:
     return mapping
 
 
+def _line_offsets(text: str) -> list[int]:
+    offsets = [0]
+    for line in text.splitlines(keepends=True):
+        offsets.append(offsets[-1] + len(line))
+    return offsets
+
+
+def _paren_depths(lines: list[str]) -> list[int]:
+    depths: list[int] = []
+    depth = 0
+    for line in lines:
+        depths.append(depth)
+        depth = max(0, depth + line.count("(") - line.count(")"))
+    return depths
+
+
+def _enclosing_namespace(
+    namespaces: list[_NamespaceBlock], line_number: int, depth: int
+) -> _NamespaceBlock | None:
+    for block in reversed(namespaces):
+        if block.start_line < line_number <= block.end_line and block.depth == depth:
+            return block
+    return None
+
+
+def _enclosing_type(
+    type_blocks: list[_TypeBlock], line_number: int, depth: int
+) -> _TypeBlock | None:
+    for block in reversed(type_blocks):
+        if block.start_line < line_number <= block.end_line and block.member_depth == depth:
+            return block
+    return None
+
+
+def _csharp_access(modifiers: str, owner: _TypeBlock | None) -> str:
+    """Return the declared access level, applying C#'s implicit defaults.
+
+    Namespace-level types default to `internal`, interface members to
+    `public`, and every other member or nested type to `private`.
+    """
+    words = set(modifiers.split())
+    if {"protected", "internal"} <= words:
+        return "protected internal"
+    if {"private", "protected"} <= words:
+        return "private protected"
+    for access in ("public", "protected", "internal", "private", "file"):
+        if access in words:
+            return access
+    if owner is None:
+        return "internal"
+    if owner.kind in _IMPLICITLY_PUBLIC_OWNERS:
+        return "public"
+    return "private"
+
+
+def _csharp_visibility(access: str) -> str:
+    return "public" if access == "public" else "private"
+
+
+def _attributes(raw_lines: list[str], lines: list[str], index: int) -> tuple[str, ...]:
+    """Return attributes on the declaration line and attribute-only lines above it.
+
+    Each attribute of a `[A, B(...)]` list is reported separately with its
+    arguments as written, without the brackets.
+    """
+    rows: list[tuple[str, str, int]] = []
+    above = index - 1
+    while above >= 0 and _ATTRIBUTE_LINE_RE.match(lines[above]):
+        rows.append((raw_lines[above], lines[above], len(lines[above])))
+        above -= 1
+    rows.reverse()
+    prefix = _ATTRIBUTE_PREFIX_RE.match(lines[index])
+    rows.append((raw_lines[index], lines[index], prefix.end() if prefix is not None else 0))
+    attributes: list[str] = []
+    for raw_line, masked_line, end in rows:
+        for section in _ATTRIBUTE_RE.finditer(masked_line, 0, end):
+            start = section.start() + 1
+            for piece_start, piece_end in _top_level_pieces(masked_line, start, section.end() - 1):
+                written = " ".join(raw_line[piece_start:piece_end].split())
+                if written:
+                    attributes.append(written)
+    return tuple(attributes)
+
+
+def _top_level_pieces(masked_line: str, start: int, end: int) -> list[tuple[int, int]]:
+    pieces: list[tuple[int, int]] = []
+    depth = 0
+    piece_start = start
+    for position in range(start, end):
+        char = masked_line[position]
+        if char == "(":
+            depth += 1
+        elif char == ")":
+            depth -= 1
+        elif char == "," and depth == 0:
+            pieces.append((piece_start, position))
+            piece_start = position + 1
+    pieces.append((piece_start, end))
+    return pieces
+
+
+def _with_attributes(symbol: OutlineSymbol, attributes: tuple[str, ...]) -> OutlineSymbol:
+    symbol = replace(symbol, decorators=attributes or None)
+    note: str | None = None
+    for attribute in attributes:
+        name, _, arguments = attribute.partition("(")
+        if name.strip() not in _OBSOLETE_ATTRIBUTES:
+            continue
+        message = _OBSOLETE_NOTE_RE.match(f"({arguments}")
+        note = message.group(1) if message is not None else ""
+        break
+    return mark_deprecation(symbol, note)
+
+
+def _declarator_names(masked_line: str, start: int) -> list[str]:
+    """Return the variable names declared from start to the end of a field line."""
+    names: list[str] = []
+    depth = 0
+    piece_start = start
+    for position in range(start, len(masked_line) + 1):
+        char = masked_line[position] if position < len(masked_line) else ";"
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+        elif depth == 0 and char in ",;":
+            name = _DECLARATOR_NAME_RE.match(masked_line[piece_start:position])
+            if name is not None:
+                names.append(name.group(1))
+            if char == ";":
+                break
+            piece_start = position + 1
+    return names
+
+
+def _property_accessors(
+    masked: str,
+    lines: list[str],
+    line_offsets: list[int],
+    index: int,
+    matched: re.Match[str],
+    block_ends: dict[int, int],
+) -> tuple[tuple[str, ...], int] | None:
+    """Return a property's accessors and end line, or None when no accessor block follows.
+
+    Expression-bodied properties (`=> expr;`) are read-only and report `get`.
+    Accessors keep their access modifier, such as `private set`.
+    """
+    line_number = index + 1
+    if matched.group("body") == "=>":
+        return ("get",), line_number
+    if matched.group("body") == "{":
+        brace_line = line_number
+        brace = line_offsets[index] + matched.start("body")
+    else:
+        following = index + 1
+        while following < len(lines) and not lines[following].strip():
+            following += 1
+        if following >= len(lines) or not lines[following].lstrip().startswith("{"):
+            return None
+        brace_line = following + 1
+        brace = line_offsets[following] + lines[following].index("{")
+    outer: list[str] = []
+    depth = 0
+    for char in masked[brace:]:
+        if char == "{":
+            depth += 1
+            if depth == 1:
+                continue
+        elif char == "}":
+            depth -= 1
+            if depth == 0:
+                break
+            if depth == 1:
+                outer.append("}")
+            continue
+        if depth == 1:
+            outer.append(char)
+    accessors = tuple(
+        " ".join(found.group("accessor").split())
+        for found in _ACCESSOR_RE.finditer("".join(outer))
+    )
+    if not accessors:
+        return None
+    return accessors, max(line_number, block_ends.get(brace_line, brace_line))
+
+
+def _member_symbol(
+    matched: re.Match[str],
+    type_block: _TypeBlock,
+    attributes: tuple[str, ...],
+    *,
+    kind: str,
+    name: str,
+    signature: str,
+    start_line: int,
+    end_line: int,
+    accessors: tuple[str, ...] | None = None,
+) -> OutlineSymbol:
+    access = _csharp_access(matched.group("modifiers"), type_block)
+    return _with_attributes(
+        OutlineSymbol(
+            kind=kind,
+            name=name,
+            signature=signature,
+            start_line=start_line,
+            end_line=end_line,
+            doc=None,
+            parent_symbol=type_block.name,
+            scope_kind="class",
+            visibility=_csharp_visibility(access),
+            access=access,
+            accessors=accessors,
+        ),
+        attributes,
+    )
+
+
 def _extract_type_members(
+    masked: str,
     lines: list[str],
+    raw_lines: list[str],
+    line_offsets: list[int],
     depth_before: list[int],
+    paren_before: list[int],
     block_ends: dict[int, int],
     type_block: _TypeBlock,
 ) -> list[OutlineSymbol]:
     symbols: list[OutlineSymbol] = []
+    if type_block.kind == "enum":
+        return symbols
     start = max(type_block.start_line + 1, 1)
     end = min(type_block.end_line, len(lines))
-    simple_type_name = type_block.name.split(".")[-1]
     for line_number in range(start, end + 1):
-        if depth_before[line_number - 1] != type_block.member_depth:
+        index = line_number - 1
+        if depth_before[index] != type_block.member_depth or paren_before[index] != 0:
             continue
-        line = lines[line_number - 1]
+        line = lines[index]
+        if _TYPE_RE.match(line) is not None or _ATTRIBUTE_LINE_RE.match(line) is not None:
+            continue
+        attributes = _attributes(raw_lines, lines, index)
 
         event_match = _EVENT_RE.match(line)
         if event_match is not None:
-            event_name = event_match.group(1)
             symbols.append(
-                OutlineSymbol(
+                _member_symbol(
+                    event_match,
+                    type_block,
+                    attributes,
                     kind="event",
-                    name=f"{type_block.name}.{event_name}",
-                    signature=None,
+                    name=f"{type_block.name}.{event_match.group('name')}",
+                    signature=" ".join(event_match.group("type").split()),
                     start_line=line_number,
                     end_line=line_number,
-                    doc=None,
                 )
             )
             continue
 
         property_match = _PROPERTY_RE.match(line)
-        if property_match is not None:
-            property_name = property_match.group(1)
+        if property_match is not None and property_match.group("type") not in _MEMBER_SKIP_TYPES:
+            found = _property_accessors(
+                masked, lines, line_offsets, index, property_match, block_ends
+            )
+            if found is not None:
+                accessors, property_end = found
+                symbols.append(
+                    _member_symbol(
+                        property_match,
+                        type_block,
+                        attributes,
+                        kind="property",
+                        name=f"{type_block.name}.{property_match.group('name')}",
+                        signature=" ".join(property_match.group("type").split()),
+                        start_line=line_number,
+                        end_line=property_end,
+                        accessors=accessors,
+                    )
+                )
+                continue
+
+        method_match = _METHOD_RE.match(line)
+        if method_match is not None and method_match.group("name") not in _METHOD_SKIP:
+            member_name = method_match.group("name")
+            if method_match.group("terminator") in (";", "=>"):
+                end_line = line_number
+            else:
+                end_line = _declaration_end(line_number, line, block_ends, lookahead=2)
             symbols.append(
-                OutlineSymbol(
-                    kind="property",
-                    name=f"{type_block.name}.{property_name}",
-                    signature=None,
+                _member_symbol(
+                    method_match,
+                    type_block,
+                    attributes,
+                    kind="constructor" if member_name == type_block.simple_name else "method",
+                    name=f"{type_block.name}.{member_name}",
+                    signature=f"({method_match.group('params').strip()})",
                     start_line=line_number,
-                    end_line=max(line_number, block_ends.get(line_number, line_number)),
-                    doc=None,
+                    end_line=end_line,
                 )
             )
             continue
 
-        method_match = _METHOD_RE.match(line)
-        if method_match is None:
-            continue
-        member_name, params, terminator = method_match.groups()
-        if member_name in _METHOD_SKIP:
+        field_match = _FIELD_RE.match(line)
+        if field_match is None or field_match.group("type") in _MEMBER_SKIP_TYPES:
             continue
-        kind = "constructor" if member_name == simple_type_name else "method"
-        if terminator in (";", "=>"):
-            end_line = line_number
-        else:
-            end_line = _declaration_end(line_number, line, block_ends, lookahead=2)
-        symbols.append(
-            OutlineSymbol(
-                kind=kind,
-                name=f"{type_block.name}.{member_name}",
-                signature=f"({params.strip()})",
-                start_line=line_number,
-                end_line=end_line,
-                doc=None,
+        field_type = " ".join(field_match.group("type").split())
+        for name in _declarator_names(line, field_match.start("name")):
+            symbols.append(
+                _member_symbol(
+                    field_match,
+                    type_block,
+                    attributes,
+                    kind="field",
+                    name=f"{type_block.name}.{name}",
+                    signature=field_type,
+                    start_line=line_number,
+                    end_line=line_number,
+                )
             )
-        )
     return symbols
 
 
+def _namespace_packages(symbols: list[OutlineSymbol]) -> list[OutlineSymbol]:
+    """Set each non-namespace symbol's package to the namespace declared above it.
+
+    Block namespaces only cover their own lines; a file-scoped namespace covers
+    the rest of the file.
+    """
+    namespaces = sorted(
+        (symbol for symbol in symbols if symbol.kind == "namespace"),
+        key=lambda item: item.start_line,
+    )
+    output: list[OutlineSymbol] = []
+    for symbol in symbols:
+        package: str | None = None
+        if symbol.kind != "namespace":
+            for namespace in namespaces:
+                if namespace.start_line > symbol.start_line:
+                    break
+                file_scoped = namespace.end_line == namespace.start_line
+                if file_scoped or symbol.start_line <= namespace.end_line:
+                    package = namespace.name
+        output.append(replace(symbol, package=package))
+    return output
+
+
 def _qualify(namespace: str | None, type_name: str) -> str:
     if namespace is None:
         return type_name
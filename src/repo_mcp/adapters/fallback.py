@@ -2,7 +2,7 @@
 
 from __future__ import annotations
 
-from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.adapters.base import FileImport, OutlineSymbol, ParseDiagnostic
 
 
 class LexicalFallbackAdapter:
@@ -31,3 +31,15 @@ class LexicalFallbackAdapter:
         """Fallback provides no symbol hints."""
         _ = prompt
         return ()
+
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """Fallback does not extract imports."""
+        _ = path
+        _ = text
+        return None
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Fallback does not check for parse problems."""
+        _ = path
+        _ = text
+        return None
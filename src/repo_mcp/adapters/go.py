@@ -2,10 +2,29 @@
 
 from __future__ import annotations
 
+import ast
+import bisect
+import json
 import re
+import textwrap
+from dataclasses import dataclass, replace
 
-from repo_mcp.adapters.base import OutlineSymbol, SymbolReference, normalize_and_sort_symbols
+from repo_mcp.adapters.base import (
+    FileImport,
+    OutlineSymbol,
+    ParseDiagnostic,
+    SymbolReference,
+    assign_package,
+    assign_start_columns,
+    mark_deprecation,
+    normalize_and_sort_diagnostics,
+    normalize_and_sort_imports,
+    normalize_and_sort_symbols,
+)
+from repo_mcp.adapters.go_build import go_file_constraints
 from repo_mcp.adapters.lexical import (
+    LexicalRules,
+    brace_balance_diagnostics,
     mask_comments_and_strings,
     references_for_symbol_lexical,
     references_for_symbols_lexical,
@@ -14,10 +33,112 @@ from repo_mcp.adapters.lexical import (
 
 _PACKAGE_RE = re.compile(r"^\s*package\s+([A-Za-z_][A-Za-z0-9_]*)\b")
 _TYPE_RE = re.compile(r"^\s*type\s+([A-Za-z_][A-Za-z0-9_]*)\b")
-_FUNC_RE = re.compile(r"^\s*func\s*(?:\(([^)]*)\)\s*)?([A-Za-z_][A-Za-z0-9_]*)\s*\(([^)]*)\)")
+_FUNC_RE = re.compile(r"^\s*func\s*(?:\(([^)]*)\)\s*)?([A-Za-z_][A-Za-z0-9_]*)\s*(?=[\[(])")
+_TYPE_PARAM_CLAUSE_RE = re.compile(r"^\[\s*[A-Za-z_][A-Za-z0-9_]*\s*(?:,|\s+[^\s*\]])")
+_WHITESPACE_RE = re.compile(r"\s+")
+_COMPOSITE_TYPE_RE = re.compile(r"[ \t]*(struct|interface)[ \t]*\{")
+_INTERFACE_METHOD_RE = re.compile(r"^\s*([A-Za-z_][A-Za-z0-9_]*)\s*(?=\()")
+_EMBEDDED_RE = re.compile(
+    r"^\s*(\*?(?:[A-Za-z_][A-Za-z0-9_]*\.)?[A-Za-z_][A-Za-z0-9_]*(?:\[[^\]]*\])?)\s*$"
+)
+_FIELD_NAMES_RE = re.compile(
+    r"^\s*([A-Za-z_][A-Za-z0-9_]*(?:\s*,\s*[A-Za-z_][A-Za-z0-9_]*)*)\s+(\S.*?)\s*$"
+)
 _CONST_VAR_SINGLE_RE = re.compile(r"^\s*(const|var)\s+([A-Za-z_][A-Za-z0-9_]*)\b")
 _CONST_VAR_GROUP_START_RE = re.compile(r"^\s*(const|var)\s*\(")
 _GROUP_ENTRY_RE = re.compile(r"^\s*([A-Za-z_][A-Za-z0-9_]*)\b")
+_DIRECTIVE_RE = re.compile(r"^//(?:[a-z0-9]+:[a-z0-9]|line |export |extern )")
+_CALL_RE = re.compile(
+    r"(?<![A-Za-z0-9_.])((?:[A-Za-z_][A-Za-z0-9_]*\s*\.\s*)*[A-Za-z_][A-Za-z0-9_]*)\s*\("
+)
+# Keywords that may precede a parenthesis, builtin functions, and predeclared
+# types used in conversions; none of these are recorded as call edges.
+_NON_CALL_NAMES = frozenset(
+    (
+        "case defer for func go if interface map range return select struct switch "
+        "append cap clear close complex copy delete imag len make max min new panic print "
+        "println real recover "
+        "any bool byte complex128 complex64 error float32 float64 int int16 int32 int64 int8 "
+        "rune string uint uint16 uint32 uint64 uint8 uintptr"
+    ).split()
+)
+_IMPORT_KEYWORD_RE = re.compile(r"^\s*import\b")
+_IMPORT_GROUP_START_RE = re.compile(r"^\s*import\s*\(")
+_IMPORT_SPEC_RE = re.compile(r"^\s*(?:([A-Za-z_][A-Za-z0-9_]*|\.)\s+)?[\"`]([^\"`]+)[\"`]")
+_IMPORT_SINGLE_RE = re.compile(
+    r"^\s*import\s+(?:([A-Za-z_][A-Za-z0-9_]*|\.)\s+)?[\"`]([^\"`]+)[\"`]"
+)
+_MAJOR_VERSION_RE = re.compile(r"^v[0-9]+$")
+_GOPKG_VERSION_RE = re.compile(r"\.v[0-9]+$")
+_TEST_ROLE_PREFIXES = (
+    ("Test", "test"),
+    ("Benchmark", "benchmark"),
+    ("Fuzz", "fuzz"),
+    ("Example", "example"),
+)
+_STRINGS_ONLY_RULES = LexicalRules(line_comment_prefixes=(), block_comment_pairs=())
+_DEPRECATED_MARKER = "Deprecated:"
+_DOC_PARAGRAPH_RE = re.compile(r"\n[ \t]*\n")
+_MORE_NAMES_RE = re.compile(r"^(?:\s*,\s*[A-Za-z_][A-Za-z0-9_]*)*")
+_INT_LITERAL_RE = re.compile(
+    r"^(?:0[xX][0-9a-fA-F_]+|0[bB][01_]+|0[oO]?[0-7_]*|[1-9][0-9_]*)$"
+)
+_FLOAT_LITERAL_RE = re.compile(
+    r"^(?:[0-9][0-9_]*\.[0-9_]*(?:[eE][+-]?[0-9_]+)?|\.[0-9][0-9_]*(?:[eE][+-]?[0-9_]+)?"
+    r"|[0-9][0-9_]*[eE][+-]?[0-9_]+|0[xX][0-9a-fA-F_.]*[pP][+-]?[0-9_]+)$"
+)
+_IMAGINARY_LITERAL_RE = re.compile(r"^[0-9][0-9_.eE+-]*i$")
+_COMPOSITE_LITERAL_RE = re.compile(
+    r"^(&?)((?:\[[^\]]*\])?(?:map\[[^\]]+\])?\*?[A-Za-z_][A-Za-z0-9_.]*(?:\[[^\]]*\])?)\s*\{"
+)
+_IOTA_RE = re.compile(r"\biota\b")
+_MAX_SHIFT = 64
+_GO_STRING_LITERAL_RE = re.compile(r'"(?:[^"\\\n]|\\.)*"')
+_STRUCT_TAG_PAIR_RE = re.compile(r'([^\x00-\x20:"\x7f]+):("(?:[^"\\]|\\.)*")')
+_GO_STATEMENT_RE = re.compile(r"(?<![A-Za-z0-9_.])go(?![A-Za-z0-9_])")
+_CHANNEL_USE_RE = re.compile(r"<-|(?<![A-Za-z0-9_.])chan(?![A-Za-z0-9_])")
+_DOC_EXAMPLE_HEADING_RE = re.compile(r"^Examples?:$")
+_DECISION_POINT_RE = re.compile(r"(?<![A-Za-z0-9_.])(?:if|for|case)(?![A-Za-z0-9_])|&&|\|\|")
+_CONTEXT_IMPORT_PATH = "context"
+_RECEIVER_NAME_RE = re.compile(r"^[A-Za-z_][A-Za-z0-9_]*\s+(?=[*A-Za-z_(])")
+_TYPE_NAME_RE = re.compile(
+    r"(?<![A-Za-z0-9_.])([A-Za-z_][A-Za-z0-9_]*)(?:\.([A-Za-z_][A-Za-z0-9_]*))?"
+)
+_NAME_POSITION_RE = re.compile(r"\s+[A-Za-z_*\[(<.]")
+_SPACE_AFTER_OPENER_RE = re.compile(r"([(\[\]*])\s+")
+_SPACE_BEFORE_CLOSER_RE = re.compile(r"\s+([)\],])")
+_COMMA_RE = re.compile(r",\s*")
+_NON_TYPE_NAMES = frozenset(
+    (
+        "chan func interface map struct "
+        "any bool byte comparable complex128 complex64 error float32 float64 int int16 int32 "
+        "int64 int8 rune string uint uint16 uint32 uint64 uint8 uintptr"
+    ).split()
+)
+
+
+@dataclass(slots=True, frozen=True)
+class _FuncBody:
+    """Offsets of one function or method body within the masked source."""
+
+    symbol_index: int
+    start: int
+    end: int
+    receiver_var: str | None
+    receiver_type: str | None
+
+
+_MethodKey = tuple[str, tuple[str, ...], tuple[str, ...]]
+_COMPOSITE_KINDS = frozenset({"interface", "struct"})
+_MEMBER_NEEDING_KINDS = frozenset({"embedded", "field", "method", "type"})
+
+
+@dataclass(slots=True, frozen=True)
+class _ValueSpec:
+    """Declared type and first initializer expression of one const or var spec."""
+
+    type_name: str | None
+    expression: str | None
 
 
 class GoLexicalAdapter:
@@ -30,15 +151,43 @@ class GoLexicalAdapter:
         return path.lower().endswith(".go")
 
     def outline(self, path: str, text: str) -> list[OutlineSymbol]:
-        """Extract package-level types, funcs, methods, consts, and vars."""
-        _ = path
+        """Extract package-level types, funcs, methods, consts, and vars.
+
+        Every symbol of a `_test.go` file is flagged `is_test`; its top-level
+        `Test`, `Benchmark`, `Fuzz`, and `Example` functions also get a `role`.
+        """
+        return self.outline_kinds(path, text, None)
+
+    def outline_kinds(
+        self, path: str, text: str, kinds: frozenset[str] | None
+    ) -> list[OutlineSymbol]:
+        """Return the outline of path, skipping work only symbols outside kinds need.
+
+        Every top-level symbol is still returned, because linking a package
+        across files needs its methods and names: the caller selects kinds once
+        that is done. The calls, concurrency, complexity, and example passes
+        only read the bodies of functions and methods of those kinds, plus
+        `Example` functions, whose bodies document other symbols; references
+        and canonical signatures are only derived for symbols of those kinds;
+        struct and interface members are only extracted when a member kind or
+        `type` (whose `implements` needs them) is among them; and `implements`
+        only when `type` is. Symbols of kinds dropped this way keep defaults for
+        the skipped fields. With kinds None this is `outline`.
+        """
+        is_test = path.lower().endswith("_test.go")
         masked = mask_comments_and_strings(text)
         lines = masked.splitlines()
+        raw_lines = text.splitlines()
+        strings_masked_lines = mask_comments_and_strings(text, _STRINGS_ONLY_RULES).splitlines()
         depth_before = _line_depths(masked)
         block_ends = _block_end_by_start_line(masked)
+        line_offsets = _line_offsets(masked)
         package_name = _find_package(lines)
 
+        members_needed = kinds is None or not kinds.isdisjoint(_MEMBER_NEEDING_KINDS)
+
         symbols: list[OutlineSymbol] = []
+        bodies: list[_FuncBody] = []
         index = 0
         while index < len(lines):
             line_number = index + 1
@@ -50,24 +199,55 @@ class GoLexicalAdapter:
             type_match = _TYPE_RE.match(line)
             if type_match is not None:
                 type_name = type_match.group(1)
+                after_name = line_offsets[index] + type_match.end()
+                type_params = _read_balanced(masked, after_name, "[", "]")
+                type_signature = None
+                if type_params is not None and _TYPE_PARAM_CLAUSE_RE.match(type_params[0]):
+                    type_signature = _normalize_clause(type_params[0])
+                    after_name = type_params[1]
+                qualified_type = _qualify(package_name, type_name)
+                type_end = max(line_number, block_ends.get(line_number, line_number))
+                composite = _COMPOSITE_TYPE_RE.match(masked, after_name)
                 symbols.append(
                     OutlineSymbol(
                         kind="type",
-                        name=_qualify(package_name, type_name),
-                        signature=None,
+                        name=qualified_type,
+                        signature=type_signature,
                         start_line=line_number,
-                        end_line=max(line_number, block_ends.get(line_number, line_number)),
-                        doc=None,
+                        end_line=type_end,
+                        doc=_declaration_doc(raw_lines, lines, strings_masked_lines, index),
+                        decl_context=composite.group(1) if composite is not None else None,
                     )
                 )
+                if composite is not None and type_end > line_number and members_needed:
+                    symbols.extend(
+                        _extract_type_members(
+                            composite_kind=composite.group(1),
+                            parent=qualified_type,
+                            body_start=index + 1,
+                            body_end=type_end - 1,
+                            masked=masked,
+                            line_offsets=line_offsets,
+                            raw_lines=raw_lines,
+                            strings_masked_lines=strings_masked_lines,
+                            depth_before=depth_before,
+                            block_ends=block_ends,
+                        )
+                    )
                 index += 1
                 continue
 
             func_match = _FUNC_RE.match(line)
             if func_match is not None:
-                receiver, name, params = func_match.groups()
-                end_line = max(line_number, block_ends.get(line_number, line_number))
-                signature = f"({params.strip()})"
+                receiver, name = func_match.groups()
+                clauses = _read_func_clauses(masked, line_offsets[index] + func_match.end())
+                if clauses is None:
+                    index += 1
+                    continue
+                signature, clause_end = clauses
+                header_line = _line_index_for_offset(line_offsets, clause_end) + 1
+                end_line = max(line_number, block_ends.get(header_line, header_line))
+                receiver_type = None
                 if receiver is None:
                     kind = "function"
                     symbol_name = _qualify(package_name, name)
@@ -76,6 +256,16 @@ class GoLexicalAdapter:
                     receiver_type = _parse_receiver_type(receiver)
                     method_base = f"{receiver_type}.{name}" if receiver_type else name
                     symbol_name = _qualify(package_name, method_base)
+                body_end = line_offsets[end_line] if end_line < len(line_offsets) else len(masked)
+                bodies.append(
+                    _FuncBody(
+                        symbol_index=len(symbols),
+                        start=clause_end,
+                        end=body_end,
+                        receiver_var=_parse_receiver_var(receiver) if receiver else None,
+                        receiver_type=receiver_type,
+                    )
+                )
                 symbols.append(
                     OutlineSymbol(
                         kind=kind,
@@ -83,7 +273,11 @@ class GoLexicalAdapter:
                         signature=signature,
                         start_line=line_number,
                         end_line=end_line,
-                        doc=None,
+                        doc=_declaration_doc(raw_lines, lines, strings_masked_lines, index),
+                        start_col=func_match.start(2) + 1,
+                        returns=_read_result_types(masked, clause_end),
+                        receiver=_receiver_text(receiver) if receiver is not None else None,
+                        receiver_name=_parse_receiver_var(receiver) if receiver else None,
                     )
                 )
                 index += 1
@@ -92,6 +286,10 @@ class GoLexicalAdapter:
             single_match = _CONST_VAR_SINGLE_RE.match(line)
             if single_match is not None:
                 decl_kind, name = single_match.groups()
+                spec = _parse_value_spec(
+                    raw_lines[index], line, strings_masked_lines[index], single_match.end(2)
+                )
+                value, value_type, iota_value = _resolve_value(decl_kind, spec, None, 0)
                 symbols.append(
                     OutlineSymbol(
                         kind=decl_kind,
@@ -99,7 +297,10 @@ class GoLexicalAdapter:
                         signature=None,
                         start_line=line_number,
                         end_line=line_number,
-                        doc=None,
+                        doc=_declaration_doc(raw_lines, lines, strings_masked_lines, index),
+                        value=value,
+                        value_type=value_type,
+                        iota_value=iota_value,
                     )
                 )
                 index += 1
@@ -109,6 +310,9 @@ class GoLexicalAdapter:
             if group_start is not None:
                 decl_kind = group_start.group(1)
                 group_end = _find_group_end(lines, start_index=index)
+                group_doc = _leading_doc(raw_lines, lines, index)
+                previous_spec: _ValueSpec | None = None
+                spec_index = 0
                 for group_line_idx in range(index + 1, group_end):
                     if depth_before[group_line_idx] != 0:
                         continue
@@ -116,6 +320,21 @@ class GoLexicalAdapter:
                     if entry is None:
                         continue
                     name = entry.group(1)
+                    entry_doc = _declaration_doc(
+                        raw_lines, lines, strings_masked_lines, group_line_idx
+                    )
+                    spec = _parse_value_spec(
+                        raw_lines[group_line_idx],
+                        lines[group_line_idx],
+                        strings_masked_lines[group_line_idx],
+                        entry.end(1),
+                    )
+                    value, value_type, iota_value = _resolve_value(
+                        decl_kind, spec, previous_spec, spec_index
+                    )
+                    if spec.expression is not None or spec.type_name is not None:
+                        previous_spec = spec
+                    spec_index += 1
                     symbols.append(
                         OutlineSymbol(
                             kind=decl_kind,
@@ -123,7 +342,10 @@ class GoLexicalAdapter:
                             signature=None,
                             start_line=group_line_idx + 1,
                             end_line=group_line_idx + 1,
-                            doc=None,
+                            doc=entry_doc if entry_doc is not None else group_doc,
+                            value=value,
+                            value_type=value_type,
+                            iota_value=iota_value,
                         )
                     )
                 index = group_end + 1
@@ -131,7 +353,42 @@ class GoLexicalAdapter:
 
             index += 1
 
-        return normalize_and_sort_symbols(symbols)
+        if kinds is not None:
+            bodies = [
+                body
+                for body in bodies
+                if symbols[body.symbol_index].kind in kinds
+                or (is_test and _test_role(symbols[body.symbol_index]) == "example")
+            ]
+        _attach_calls(symbols, bodies, masked, package_name)
+        _attach_concurrency(symbols, bodies, masked, _context_qualifiers(raw_lines, lines))
+        import_qualifiers = _import_qualifiers(raw_lines, lines)
+        _attach_type_references(symbols, package_name, import_qualifiers, kinds)
+        _attach_canonical_signatures(symbols, import_qualifiers, kinds)
+        _attach_complexity(symbols, bodies, masked)
+        _attach_examples(symbols, bodies, text, masked, is_test=is_test)
+        if kinds is None or "type" in kinds:
+            symbols = go_implements(symbols, package_name)
+        build_constraints = go_file_constraints(path, raw_lines)
+        return normalize_and_sort_symbols(
+            assign_package(
+                assign_start_columns(
+                    [
+                        replace(
+                            mark_deprecation(symbol, _deprecation_note(symbol.doc)),
+                            visibility=_go_visibility(symbol.name),
+                            is_test=is_test,
+                            role=_test_role(symbol) if is_test else None,
+                            build_constraints=build_constraints,
+                        )
+                        for symbol in symbols
+                    ],
+                    text,
+                ),
+                package_name,
+                prefix_names=False,
+            )
+        )
 
     def smart_chunks(self, path: str, text: str) -> list[tuple[int, int]] | None:
         """Go adapter does not provide smart chunk ranges in v1."""
@@ -144,6 +401,38 @@ class GoLexicalAdapter:
         _ = prompt
         return ()
 
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """Extract single and grouped import specs with alias and usage.
+
+        An import is used when its package identifier (the alias, or the last
+        import path element) appears as a selector qualifier such as `fmt.`
+        outside comments and strings. Blank (`_`) and dot imports report
+        `used` as `None`.
+        """
+        _ = path
+        masked = mask_comments_and_strings(text)
+        specs = _import_specs(text.splitlines(), masked.splitlines())
+        records: list[FileImport] = []
+        for line_number, alias, import_path in specs:
+            if alias in {"_", "."}:
+                used = None
+            else:
+                qualifier = re.escape(alias or go_import_package_name(import_path))
+                used = re.search(rf"(?<![A-Za-z0-9_.]){qualifier}\s*\.", masked) is not None
+            records.append(
+                FileImport(path=import_path, name=None, alias=alias, line=line_number, used=used)
+            )
+        return normalize_and_sort_imports(records)
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Report unbalanced braces outside comments and strings and a missing package clause."""
+        _ = path
+        masked = mask_comments_and_strings(text)
+        found = brace_balance_diagnostics(masked)
+        if _find_package(masked.splitlines()) is None:
+            found.append(ParseDiagnostic(line=None, message="missing package clause"))
+        return normalize_and_sort_diagnostics(found)
+
     def references_for_symbol(
         self,
         symbol: str,
@@ -205,16 +494,874 @@ def _block_end_by_start_line(masked_text: str) -> dict[int, int]:
     return mapping
 
 
+def _line_offsets(text: str) -> list[int]:
+    offsets: list[int] = []
+    cursor = 0
+    for line in text.splitlines(keepends=True):
+        offsets.append(cursor)
+        cursor += len(line)
+    return offsets
+
+
+def _line_index_for_offset(line_offsets: list[int], offset: int) -> int:
+    return max(0, bisect.bisect_right(line_offsets, offset) - 1)
+
+
+def _read_balanced(text: str, start: int, opener: str, closer: str) -> tuple[str, int] | None:
+    """Return the balanced opener..closer clause at start (after spaces) and its end offset."""
+    cursor = start
+    while cursor < len(text) and text[cursor] in " \t":
+        cursor += 1
+    if cursor >= len(text) or text[cursor] != opener:
+        return None
+    depth = 0
+    for position in range(cursor, len(text)):
+        char = text[position]
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+            if depth == 0:
+                if char != closer:
+                    return None
+                return text[cursor : position + 1], position + 1
+    return None
+
+
+def _read_func_clauses(text: str, start: int) -> tuple[str, int] | None:
+    """Return normalized `[type params](params)` text and the offset after params."""
+    type_params = _read_balanced(text, start, "[", "]")
+    cursor = start
+    prefix = ""
+    if type_params is not None:
+        prefix = _normalize_clause(type_params[0])
+        cursor = type_params[1]
+    params = _read_balanced(text, cursor, "(", ")")
+    if params is None:
+        return None
+    return prefix + _normalize_clause(params[0]), params[1]
+
+
+def _read_result_types(text: str, start: int) -> tuple[str, ...]:
+    """Return the result types declared between the params clause and the body brace.
+
+    Named results yield one type per name (`(n, m int, err error)` gives
+    `("int", "int", "error")`); a function without results yields `()`.
+    """
+    cursor = start
+    depth = 0
+    while cursor < len(text):
+        char = text[cursor]
+        if char == "\n" and depth == 0:
+            break
+        if char in "([":
+            depth += 1
+        elif char in ")]":
+            depth -= 1
+        elif char == "{" and depth == 0:
+            if not re.search(r"\b(?:struct|interface)\s*$", text[start:cursor]):
+                break
+            literal = _read_balanced(text, cursor, "{", "}")
+            if literal is None:
+                break
+            cursor = literal[1]
+            continue
+        elif char == "}" and depth == 0:
+            break
+        cursor += 1
+    result = _WHITESPACE_RE.sub(" ", text[start:cursor]).strip()
+    if not result:
+        return ()
+    grouped = _read_balanced(result, 0, "(", ")")
+    if grouped is None or grouped[1] != len(result):
+        return (result,)
+    return _field_list_types(result[1:-1])
+
+
+def _field_list_types(field_list: str) -> tuple[str, ...]:
+    """Return one type per entry of a parameter or result list, dropping names."""
+    elements = [element.strip() for element in _split_top_level(field_list)]
+    elements = [element for element in elements if element]
+    if not any(_is_named_result(element) for element in elements):
+        return tuple(elements)
+    types: list[str] = []
+    pending = 0
+    for element in elements:
+        parts = element.split(" ", 1)
+        if len(parts) == 1:
+            pending += 1
+            continue
+        types.extend([parts[1]] * (pending + 1))
+        pending = 0
+    return tuple(types)
+
+
+def _is_named_result(element: str) -> bool:
+    parts = element.split(" ", 1)
+    return (
+        len(parts) == 2
+        and re.fullmatch(r"[A-Za-z_][A-Za-z0-9_]*", parts[0]) is not None
+        and parts[0] not in {"chan", "func", "interface", "map", "struct"}
+    )
+
+
+def _split_top_level(text: str) -> list[str]:
+    parts: list[str] = []
+    depth = 0
+    current = 0
+    for position, char in enumerate(text):
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+        elif char == "," and depth == 0:
+            parts.append(text[current:position])
+            current = position + 1
+    parts.append(text[current:])
+    return parts
+
+
+def _normalize_clause(clause: str) -> str:
+    opener, body, closer = clause[0], clause[1:-1], clause[-1]
+    normalized = _WHITESPACE_RE.sub(" ", body).strip().rstrip(",").rstrip()
+    return f"{opener}{normalized}{closer}"
+
+
 def _parse_receiver_type(receiver: str) -> str | None:
-    stripped = receiver.strip()
-    if not stripped:
+    written = _receiver_text(receiver)
+    if written is None:
         return None
-    parts = stripped.split()
-    type_part = parts[-1] if parts else stripped
-    type_part = type_part.lstrip("*")
+    type_part = written.lstrip("*").split("[", 1)[0].strip()
     return type_part if type_part else None
 
 
+def _declaration_doc(
+    raw_lines: list[str],
+    masked_lines: list[str],
+    strings_masked_lines: list[str],
+    index: int,
+) -> str | None:
+    leading = _leading_doc(raw_lines, masked_lines, index)
+    if leading is not None:
+        return leading
+    return _trailing_comment(raw_lines, strings_masked_lines, index)
+
+
+def _leading_doc(raw_lines: list[str], masked_lines: list[str], index: int) -> str | None:
+    """Return the comment group ending directly above line index, if any."""
+    collected: list[str] = []
+    cursor = index - 1
+    while cursor >= 0:
+        if masked_lines[cursor].strip():
+            break
+        stripped = raw_lines[cursor].strip()
+        if stripped.startswith("//"):
+            if _DIRECTIVE_RE.match(stripped) is None:
+                collected.append(_strip_line_comment(stripped))
+            cursor -= 1
+            continue
+        if stripped.endswith("*/"):
+            block_start = cursor
+            while block_start >= 0 and "/*" not in raw_lines[block_start]:
+                block_start -= 1
+            if block_start < 0 or masked_lines[block_start].strip():
+                break
+            block_lines = _block_comment_lines(raw_lines[block_start : cursor + 1])
+            collected.extend(reversed(block_lines))
+            cursor = block_start - 1
+            continue
+        break
+    collected.reverse()
+    return _join_doc_lines(collected)
+
+
+def _trailing_comment(
+    raw_lines: list[str], strings_masked_lines: list[str], index: int
+) -> str | None:
+    if index >= len(raw_lines) or index >= len(strings_masked_lines):
+        return None
+    marker = strings_masked_lines[index].find("//")
+    if marker <= 0 or not strings_masked_lines[index][:marker].strip():
+        return None
+    return _join_doc_lines([raw_lines[index][marker + 2 :].strip()])
+
+
+def _strip_line_comment(stripped: str) -> str:
+    body = stripped[2:]
+    return body[1:] if body.startswith(" ") else body
+
+
+def _block_comment_lines(block: list[str]) -> list[str]:
+    joined = "\n".join(line.strip() for line in block)
+    start = joined.find("/*")
+    end = joined.rfind("*/")
+    body = joined[start + 2 : end] if end > start else joined[start + 2 :]
+    cleaned: list[str] = []
+    for line in body.splitlines():
+        stripped = line.strip()
+        if stripped.startswith("*"):
+            stripped = stripped[1:].lstrip()
+        cleaned.append(stripped)
+    return cleaned
+
+
+def _join_doc_lines(lines: list[str]) -> str | None:
+    text = "\n".join(line.rstrip() for line in lines).strip("\n")
+    return text if text.strip() else None
+
+
+def _extract_type_members(
+    *,
+    composite_kind: str,
+    parent: str,
+    body_start: int,
+    body_end: int,
+    masked: str,
+    line_offsets: list[int],
+    raw_lines: list[str],
+    strings_masked_lines: list[str],
+    depth_before: list[int],
+    block_ends: dict[int, int],
+) -> list[OutlineSymbol]:
+    """Return interface method/embedded or struct field/embedded child symbols."""
+    masked_lines = masked.splitlines()
+    members: list[OutlineSymbol] = []
+    for member_index in range(body_start, body_end):
+        if depth_before[member_index] != 1:
+            continue
+        line = masked_lines[member_index]
+        if not line.strip():
+            continue
+        line_number = member_index + 1
+        doc = _declaration_doc(raw_lines, masked_lines, strings_masked_lines, member_index)
+
+        if composite_kind == "interface":
+            method = _INTERFACE_METHOD_RE.match(line)
+            if method is not None:
+                clauses = _read_func_clauses(masked, line_offsets[member_index] + method.end())
+                if clauses is None:
+                    continue
+                members.append(
+                    OutlineSymbol(
+                        kind="method",
+                        name=f"{parent}.{method.group(1)}",
+                        signature=clauses[0],
+                        start_line=line_number,
+                        end_line=_line_index_for_offset(line_offsets, clauses[1]) + 1,
+                        doc=doc,
+                        parent_symbol=parent,
+                        scope_kind="class",
+                        returns=_read_result_types(masked, clauses[1]),
+                    )
+                )
+                continue
+
+        embedded = _EMBEDDED_RE.match(line)
+        if embedded is not None:
+            embedded_type = embedded.group(1)
+            tag = _field_tag(raw_lines[member_index], line) if composite_kind == "struct" else None
+            members.append(
+                OutlineSymbol(
+                    kind="embedded",
+                    name=f"{parent}.{_embedded_field_name(embedded_type)}",
+                    signature=embedded_type,
+                    start_line=line_number,
+                    end_line=line_number,
+                    doc=doc,
+                    parent_symbol=parent,
+                    scope_kind="class",
+                    tag=tag,
+                    tags=_parse_struct_tag(tag),
+                )
+            )
+            continue
+
+        if composite_kind != "struct":
+            continue
+        field = _FIELD_NAMES_RE.match(line)
+        if field is None:
+            continue
+        field_names, field_type = field.groups()
+        field_end = max(line_number, block_ends.get(line_number, line_number))
+        if field_type.endswith("{"):
+            field_type = field_type[:-1].rstrip()
+        tag = _field_tag(raw_lines[field_end - 1], masked_lines[field_end - 1])
+        for field_name in (name.strip() for name in field_names.split(",")):
+            members.append(
+                OutlineSymbol(
+                    kind="field",
+                    name=f"{parent}.{field_name}",
+                    signature=_WHITESPACE_RE.sub(" ", field_type),
+                    start_line=line_number,
+                    end_line=field_end,
+                    doc=doc,
+                    parent_symbol=parent,
+                    scope_kind="class",
+                    tag=tag,
+                    tags=_parse_struct_tag(tag),
+                )
+            )
+    return members
+
+
+def go_implements(symbols: list[OutlineSymbol], package_name: str | None) -> list[OutlineSymbol]:
+    """Set `implements` on types whose method sets satisfy an interface among symbols.
+
+    symbols are the outline symbols of one package, from one file or several;
+    struct and interface types are recognized by their `decl_context`, and
+    methods by their `receiver`. Value-receiver methods belong to both `T` and
+    `*T`, pointer-receiver methods only to `*T`; an entry is prefixed with `*`
+    when only `*T` satisfies the interface. Methods promoted through embedded
+    types among symbols follow the same rules. Interfaces without methods, or
+    embedding an interface declared elsewhere, are skipped because their
+    method set is not known here. Existing `implements` values are replaced.
+    """
+    composite_kinds = {
+        symbol.name: symbol.decl_context
+        for symbol in symbols
+        if symbol.kind == "type" and symbol.decl_context in _COMPOSITE_KINDS
+    }
+    interface_methods: dict[str, set[_MethodKey]] = {}
+    interface_embeds: dict[str, list[str]] = {}
+    struct_embeds: dict[str, list[str]] = {}
+    value_sets: dict[str, set[_MethodKey]] = {}
+    pointer_sets: dict[str, set[_MethodKey]] = {}
+    for symbol in symbols:
+        if symbol.kind == "method" and symbol.receiver is not None:
+            type_name, _, method_name = symbol.name.rpartition(".")
+            key = (
+                method_name,
+                _field_list_types((symbol.signature or "()")[1:-1]),
+                symbol.returns or (),
+            )
+            pointer_sets.setdefault(type_name, set()).add(key)
+            if not symbol.receiver.startswith("*"):
+                value_sets.setdefault(type_name, set()).add(key)
+            continue
+        parent = symbol.parent_symbol
+        if parent is None or parent not in composite_kinds:
+            continue
+        if composite_kinds[parent] == "interface" and symbol.kind == "method":
+            key = (
+                symbol.name.rsplit(".", 1)[-1],
+                _field_list_types((symbol.signature or "()")[1:-1]),
+                symbol.returns or (),
+            )
+            interface_methods.setdefault(parent, set()).add(key)
+        elif symbol.kind == "embedded" and symbol.signature:
+            embeds = interface_embeds if composite_kinds[parent] == "interface" else struct_embeds
+            embeds.setdefault(parent, []).append(symbol.signature)
+
+    def interface_set(name: str, seen: frozenset[str]) -> set[_MethodKey] | None:
+        methods = set(interface_methods.get(name, set()))
+        for embedded in interface_embeds.get(name, []):
+            target = _qualify(package_name, embedded)
+            if composite_kinds.get(target) != "interface" or target in seen:
+                return None
+            nested = interface_set(target, seen | {target})
+            if nested is None:
+                return None
+            methods |= nested
+        return methods
+
+    def method_sets(name: str, seen: frozenset[str]) -> tuple[set[_MethodKey], set[_MethodKey]]:
+        value = set(value_sets.get(name, set()))
+        pointer = set(pointer_sets.get(name, set()))
+        for embedded in struct_embeds.get(name, []):
+            target = _qualify(package_name, embedded.lstrip("*").split("[", 1)[0])
+            if target in seen or target not in composite_kinds and target not in pointer_sets:
+                continue
+            if composite_kinds.get(target) == "interface":
+                promoted = interface_set(target, seen | {target}) or set()
+                value |= promoted
+                pointer |= promoted
+                continue
+            nested_value, nested_pointer = method_sets(target, seen | {target})
+            value |= nested_pointer if embedded.startswith("*") else nested_value
+            pointer |= nested_pointer
+        return value, pointer
+
+    satisfiable = {
+        name: methods
+        for name in sorted(composite_kinds)
+        if composite_kinds[name] == "interface"
+        for methods in [interface_set(name, frozenset({name}))]
+        if methods
+    }
+    output: list[OutlineSymbol] = []
+    for symbol in symbols:
+        if symbol.kind != "type" or composite_kinds.get(symbol.name) == "interface":
+            output.append(symbol)
+            continue
+        value, pointer = method_sets(symbol.name, frozenset({symbol.name}))
+        implements = [
+            name if methods <= value else f"*{name}"
+            for name, methods in satisfiable.items()
+            if methods <= pointer
+        ]
+        output.append(replace(symbol, implements=tuple(implements) if implements else None))
+    return output
+
+
+def _receiver_text(receiver: str) -> str | None:
+    """Return the receiver type as written, `*T` for a pointer receiver, without its name."""
+    written = _RECEIVER_NAME_RE.sub("", _WHITESPACE_RE.sub(" ", receiver.strip()), count=1)
+    written = written.replace("* ", "*")
+    return written or None
+
+
+def _parse_receiver_var(receiver: str) -> str | None:
+    parts = receiver.strip().split()
+    if len(parts) < 2 or parts[0] == "_":
+        return None
+    return parts[0]
+
+
+def _import_specs(
+    raw_lines: list[str],
+    masked_lines: list[str],
+) -> list[tuple[int, str | None, str]]:
+    """Return `(line, alias, path)` for every import spec, single or grouped."""
+    specs: list[tuple[int, str | None, str]] = []
+    index = 0
+    while index < len(masked_lines):
+        if _IMPORT_GROUP_START_RE.match(masked_lines[index]):
+            group_end = _find_group_end(masked_lines, start_index=index)
+            candidates = range(index + 1, group_end)
+            pattern = _IMPORT_SPEC_RE
+            index = group_end + 1
+        elif _IMPORT_KEYWORD_RE.match(masked_lines[index]):
+            candidates = range(index, index + 1)
+            pattern = _IMPORT_SINGLE_RE
+            index += 1
+        else:
+            index += 1
+            continue
+        for spec_index in candidates:
+            match = pattern.match(raw_lines[spec_index])
+            if match is not None:
+                specs.append((spec_index + 1, match.group(1), match.group(2)))
+    return specs
+
+
+def go_import_package_name(import_path: str) -> str:
+    """Return the conventional package identifier for an import path.
+
+    This is the last path element, skipping a trailing major version element
+    such as `/v2` and dropping a gopkg.in style `.vN` suffix.
+    """
+    elements = [element for element in import_path.split("/") if element]
+    if len(elements) > 1 and _MAJOR_VERSION_RE.match(elements[-1]):
+        elements.pop()
+    last = elements[-1] if elements else import_path
+    return _GOPKG_VERSION_RE.sub("", last).replace("-", "_")
+
+
+def _attach_calls(
+    symbols: list[OutlineSymbol],
+    bodies: list[_FuncBody],
+    masked: str,
+    package_name: str | None,
+) -> None:
+    """Populate `calls` on function and method symbols, in first-call order.
+
+    Bare calls to functions declared in this file, and calls through the
+    receiver variable to methods declared on the receiver type, resolve to
+    qualified symbol names. Other calls, including cross-package `pkg.Func`
+    calls, are recorded unresolved as written. Builtins, keywords,
+    and conversions to predeclared or file-local types are skipped.
+    """
+    prefix = f"{package_name}." if package_name else ""
+    functions = {symbol.name[len(prefix) :] for symbol in symbols if symbol.kind == "function"}
+    methods = {symbol.name[len(prefix) :] for symbol in symbols if symbol.kind == "method"}
+    local_types = {symbol.name.rsplit(".", 1)[-1] for symbol in symbols if symbol.kind == "type"}
+    for body in bodies:
+        calls: list[str] = []
+        for match in _CALL_RE.finditer(masked, body.start, body.end):
+            callee = _WHITESPACE_RE.sub("", match.group(1))
+            parts = callee.split(".")
+            if len(parts) == 1:
+                if callee in _NON_CALL_NAMES or callee in local_types:
+                    continue
+                resolved = _qualify(package_name, callee) if callee in functions else callee
+            elif parts[0] == body.receiver_var and len(parts) == 2 and body.receiver_type:
+                method_key = f"{body.receiver_type}.{parts[1]}"
+                resolved = _qualify(package_name, method_key) if method_key in methods else callee
+            else:
+                resolved = callee
+            if resolved not in calls:
+                calls.append(resolved)
+        symbols[body.symbol_index] = replace(symbols[body.symbol_index], calls=tuple(calls))
+
+
+def _attach_concurrency(
+    symbols: list[OutlineSymbol],
+    bodies: list[_FuncBody],
+    masked: str,
+    context_qualifiers: frozenset[str],
+) -> None:
+    """Set goroutine, channel, and `context.Context` flags on function and method symbols.
+
+    Detection is syntactic: a `go` statement anywhere in the body, including
+    function literals, spawns a goroutine; a `<-` send or receive or any
+    `chan` type in the signature or body, such as `make(chan T)` or a
+    `<-chan T` parameter, uses channels. `takes_context` is set when the
+    first parameter's type is `Context` from the `context` import.
+    """
+    for body in bodies:
+        symbol = symbols[body.symbol_index]
+        source = masked[body.start : body.end]
+        symbols[body.symbol_index] = replace(
+            symbol,
+            spawns_goroutine=_GO_STATEMENT_RE.search(source) is not None,
+            uses_channels=_CHANNEL_USE_RE.search(f"{symbol.signature} {source}") is not None,
+            takes_context=_first_param_type(symbol.signature) in context_qualifiers,
+        )
+
+
+def _attach_complexity(symbols: list[OutlineSymbol], bodies: list[_FuncBody], masked: str) -> None:
+    """Set cyclomatic `complexity` and `line_count` on function and method symbols.
+
+    Complexity is 1 plus one per decision point in the body outside comments
+    and strings: each `if` (an `else if` counts once), `for`, `case` of a
+    `switch` or `select` (`default` does not count), `&&`, and `||`. Decision
+    points inside function literals count toward the enclosing declaration.
+    `line_count` spans the declaration from `func` to the closing brace.
+    """
+    for body in bodies:
+        symbol = symbols[body.symbol_index]
+        decisions = len(_DECISION_POINT_RE.findall(masked, body.start, body.end))
+        symbols[body.symbol_index] = replace(
+            symbol,
+            complexity=1 + decisions,
+            line_count=symbol.end_line - symbol.start_line + 1,
+        )
+
+
+def _attach_examples(
+    symbols: list[OutlineSymbol],
+    bodies: list[_FuncBody],
+    text: str,
+    masked: str,
+    *,
+    is_test: bool,
+) -> None:
+    """Record usage snippets in `examples`.
+
+    An `ExampleXxx` function of a `_test.go` file records its own body,
+    dedented, so it can be linked to the symbol it documents once the whole
+    package is known. A doc comment line `Example:` followed by an indented
+    code block records that block on the documented symbol.
+    """
+    bodies_by_index = {body.symbol_index: body for body in bodies}
+    for index, symbol in enumerate(symbols):
+        snippets = _doc_examples(symbol.doc)
+        body = bodies_by_index.get(index)
+        if is_test and body is not None and _test_role(symbol) == "example":
+            snippet = _body_snippet(text, masked, body)
+            if snippet is not None:
+                snippets.append(snippet)
+        if snippets:
+            symbols[index] = replace(symbol, examples=tuple(snippets))
+
+
+def _doc_examples(doc: str | None) -> list[str]:
+    if not doc:
+        return []
+    lines = doc.splitlines()
+    snippets: list[str] = []
+    index = 0
+    while index < len(lines):
+        if _DOC_EXAMPLE_HEADING_RE.match(lines[index].strip()) is None:
+            index += 1
+            continue
+        index += 1
+        block: list[str] = []
+        while index < len(lines) and (not lines[index].strip() or lines[index][:1] in " \t"):
+            block.append(lines[index])
+            index += 1
+        snippet = _dedent_block(block)
+        if snippet is not None:
+            snippets.append(snippet)
+    return snippets
+
+
+def _body_snippet(text: str, masked: str, body: _FuncBody) -> str | None:
+    open_brace = masked.find("{", body.start, body.end)
+    close_brace = masked.rfind("}", open_brace + 1, body.end)
+    if open_brace < 0 or close_brace < 0:
+        return None
+    return _dedent_block(text[open_brace + 1 : close_brace].splitlines())
+
+
+def _dedent_block(lines: list[str]) -> str | None:
+    snippet = textwrap.dedent("\n".join(line.rstrip() for line in lines)).strip("\n")
+    return snippet if snippet.strip() else None
+
+
+def _context_qualifiers(raw_lines: list[str], masked_lines: list[str]) -> frozenset[str]:
+    """Return the spellings of `context.Context` valid in this file."""
+    qualifiers: set[str] = set()
+    for _, alias, import_path in _import_specs(raw_lines, masked_lines):
+        if import_path != _CONTEXT_IMPORT_PATH or alias == "_":
+            continue
+        qualifiers.add("Context" if alias == "." else f"{alias or 'context'}.Context")
+    return frozenset(qualifiers)
+
+
+def _import_qualifiers(raw_lines: list[str], masked_lines: list[str]) -> dict[str, str]:
+    """Map each package identifier usable as a selector qualifier to its import path."""
+    qualifiers: dict[str, str] = {}
+    for _, alias, import_path in _import_specs(raw_lines, masked_lines):
+        if alias in {"_", "."}:
+            continue
+        qualifiers.setdefault(alias or go_import_package_name(import_path), import_path)
+    return qualifiers
+
+
+def _attach_type_references(
+    symbols: list[OutlineSymbol],
+    package_name: str | None,
+    import_qualifiers: dict[str, str],
+    kinds: frozenset[str] | None = None,
+) -> None:
+    """Populate `references` with the types named in signatures, in first-use order.
+
+    Functions and methods contribute their type parameter constraints,
+    parameter types, and result types; struct fields, embedded types, and
+    interface methods their declared types. Package-local types are qualified
+    with the package name and imported ones with their import path (for example
+    `context.Context` or `github.com/acme/log.Logger`). Predeclared types and
+    type parameters are skipped, and so are symbols whose kind is not in kinds
+    when it is set.
+    """
+    type_params = {
+        symbol.name: _type_param_names(symbol.signature)
+        for symbol in symbols
+        if symbol.kind == "type"
+    }
+    for position, symbol in enumerate(symbols):
+        if kinds is not None and symbol.kind not in kinds:
+            continue
+        if symbol.kind in {"field", "embedded"}:
+            expressions = (symbol.signature or "",)
+        elif symbol.kind in {"function", "method"}:
+            expressions = (*_signature_types(symbol.signature), *(symbol.returns or ()))
+        else:
+            continue
+        local = set(type_params.get(symbol.parent_symbol or "", ()))
+        local.update(_type_param_names(symbol.signature))
+        if symbol.receiver is not None and "[" in symbol.receiver:
+            local.update(_type_param_names(symbol.receiver[symbol.receiver.index("[") :]))
+        references: list[str] = []
+        for expression in expressions:
+            for name in _type_names(expression, local):
+                qualifier, _, base = name.rpartition(".")
+                if qualifier:
+                    resolved = f"{import_qualifiers.get(qualifier, qualifier)}.{base}"
+                else:
+                    resolved = _qualify(package_name, name)
+                if resolved not in references:
+                    references.append(resolved)
+        symbols[position] = replace(symbol, references=tuple(references))
+
+
+def _attach_canonical_signatures(
+    symbols: list[OutlineSymbol],
+    import_qualifiers: dict[str, str],
+    kinds: frozenset[str] | None = None,
+) -> None:
+    """Populate `canonical_signature` on functions, methods, and interface methods.
+
+    The form drops cosmetic differences so diffs compare what callers see:
+    grouped names are expanded (`(a, b int)` becomes `(a int, b int)`), package
+    qualifiers are replaced by their import paths, so renaming an import alias
+    keeps the form, and spacing is normalized. Result types follow after a
+    space, parenthesized when there are several, without result names. With
+    kinds set, symbols of other kinds are skipped.
+    """
+    for position, symbol in enumerate(symbols):
+        if symbol.kind not in {"function", "method"} or symbol.signature is None:
+            continue
+        if kinds is not None and symbol.kind not in kinds:
+            continue
+        canonical = _canonical_clauses(symbol.signature, import_qualifiers)
+        if canonical is None:
+            continue
+        results = [_canonical_type(item, import_qualifiers) for item in symbol.returns or ()]
+        if len(results) == 1:
+            canonical = f"{canonical} {results[0]}"
+        elif results:
+            canonical = f"{canonical} ({', '.join(results)})"
+        symbols[position] = replace(symbol, canonical_signature=canonical)
+
+
+def _canonical_clauses(signature: str, import_qualifiers: dict[str, str]) -> str | None:
+    cursor = 0
+    prefix = ""
+    if signature.startswith("["):
+        type_params = _read_balanced(signature, 0, "[", "]")
+        if type_params is None:
+            return None
+        prefix = f"[{_canonical_field_list(type_params[0][1:-1], import_qualifiers)}]"
+        cursor = type_params[1]
+    params = _read_balanced(signature, cursor, "(", ")")
+    if params is None:
+        return None
+    return f"{prefix}({_canonical_field_list(params[0][1:-1], import_qualifiers)})"
+
+
+def _canonical_field_list(field_list: str, import_qualifiers: dict[str, str]) -> str:
+    elements = [element.strip() for element in _split_top_level(field_list)]
+    elements = [element for element in elements if element]
+    if not any(_is_named_result(element) for element in elements):
+        return ", ".join(_canonical_type(element, import_qualifiers) for element in elements)
+    expanded: list[str] = []
+    pending: list[str] = []
+    for element in elements:
+        parts = element.split(" ", 1)
+        if len(parts) == 1:
+            pending.append(parts[0])
+            continue
+        type_text = _canonical_type(parts[1], import_qualifiers)
+        expanded.extend(f"{name} {type_text}" for name in (*pending, parts[0]))
+        pending = []
+    return ", ".join(expanded)
+
+
+def _canonical_type(expression: str, import_qualifiers: dict[str, str]) -> str:
+    text = _WHITESPACE_RE.sub(" ", expression).strip()
+    text = _SPACE_AFTER_OPENER_RE.sub(r"\1", text)
+    text = _SPACE_BEFORE_CLOSER_RE.sub(r"\1", text)
+    text = _COMMA_RE.sub(", ", text)
+
+    def resolve(match: re.Match[str]) -> str:
+        qualifier, member = match.groups()
+        if member is None or qualifier not in import_qualifiers:
+            return match.group(0)
+        return f"{import_qualifiers[qualifier]}.{member}"
+
+    return _TYPE_NAME_RE.sub(resolve, text)
+
+
+def _signature_types(signature: str | None) -> tuple[str, ...]:
+    """Return the type parameter constraints and parameter types of a func signature."""
+    if not signature:
+        return ()
+    cursor = 0
+    constraints: tuple[str, ...] = ()
+    if signature.startswith("["):
+        type_params = _read_balanced(signature, 0, "[", "]")
+        if type_params is None:
+            return ()
+        constraints = _field_list_types(type_params[0][1:-1])
+        cursor = type_params[1]
+    params = _read_balanced(signature, cursor, "(", ")")
+    if params is None:
+        return constraints
+    return (*constraints, *_field_list_types(params[0][1:-1]))
+
+
+def _type_param_names(clause: str | None) -> tuple[str, ...]:
+    """Return the names declared by a leading `[...]` type parameter clause."""
+    if not clause or not clause.startswith("["):
+        return ()
+    type_params = _read_balanced(clause, 0, "[", "]")
+    if type_params is None:
+        return ()
+    names: list[str] = []
+    for element in _split_top_level(type_params[0][1:-1]):
+        match = re.match(r"\s*([A-Za-z_][A-Za-z0-9_]*)", element)
+        if match is not None:
+            names.append(match.group(1))
+    return tuple(names)
+
+
+def _type_names(expression: str, type_params: set[str]) -> list[str]:
+    """Return the named types in a type expression, as written.
+
+    Identifiers in name position (followed by a type, as in `func(ctx T)` or
+    `struct{ n int }`) and method names followed by `(` are not types.
+    """
+    names: list[str] = []
+    for match in _TYPE_NAME_RE.finditer(expression):
+        qualifier, member = match.groups()
+        following = expression[match.end() :]
+        if following.lstrip().startswith("(") or _NAME_POSITION_RE.match(following):
+            continue
+        if member is None and (qualifier in _NON_TYPE_NAMES or qualifier in type_params):
+            continue
+        names.append(match.group(0))
+    return names
+
+
+def _first_param_type(signature: str | None) -> str | None:
+    if not signature:
+        return None
+    cursor = 0
+    if signature.startswith("["):
+        type_params = _read_balanced(signature, 0, "[", "]")
+        if type_params is None:
+            return None
+        cursor = type_params[1]
+    params = _read_balanced(signature, cursor, "(", ")")
+    if params is None:
+        return None
+    types = _field_list_types(params[0][1:-1])
+    return types[0] if types else None
+
+
+def _field_tag(raw_line: str, masked_line: str) -> str | None:
+    """Return the raw struct tag text following a field declaration, if present."""
+    rest = raw_line[len(masked_line.rstrip()) :].lstrip()
+    if rest.startswith("`"):
+        closing = rest.find("`", 1)
+        return rest[1:closing] if closing != -1 else None
+    if rest.startswith('"'):
+        literal = _GO_STRING_LITERAL_RE.match(rest)
+        if literal is None:
+            return None
+        try:
+            value = json.loads(literal.group(0))
+        except ValueError:
+            return None
+        return value if isinstance(value, str) else None
+    return None
+
+
+def _parse_struct_tag(tag: str | None) -> tuple[tuple[str, str], ...] | None:
+    """Decode key:"value" pairs the way reflect.StructTag.Lookup does.
+
+    Parsing stops at the first malformed pair; the first value wins for
+    repeated keys. Returns None when no pair can be decoded.
+    """
+    if tag is None:
+        return None
+    pairs: list[tuple[str, str]] = []
+    seen: set[str] = set()
+    remaining = tag
+    while remaining:
+        remaining = remaining.lstrip(" ")
+        match = _STRUCT_TAG_PAIR_RE.match(remaining)
+        if match is None:
+            break
+        key, quoted = match.groups()
+        try:
+            value = json.loads(quoted)
+        except ValueError:
+            break
+        if key not in seen:
+            seen.add(key)
+            pairs.append((key, value))
+        remaining = remaining[match.end() :]
+    return tuple(pairs) or None
+
+
+def _embedded_field_name(embedded_type: str) -> str:
+    base = embedded_type.lstrip("*").split("[", 1)[0]
+    return base.rsplit(".", 1)[-1]
+
+
 def _find_group_end(lines: list[str], start_index: int) -> int:
     depth = 0
     for idx in range(start_index, len(lines)):
@@ -228,6 +1375,191 @@ def _find_group_end(lines: list[str], start_index: int) -> int:
     return len(lines) - 1
 
 
+def _test_role(symbol: OutlineSymbol) -> str | None:
+    """Return the `go test` role of a top-level function, following its naming rules."""
+    if symbol.kind != "function":
+        return None
+    local_name = symbol.name.rsplit(".", 1)[-1]
+    for prefix, role in _TEST_ROLE_PREFIXES:
+        if local_name.startswith(prefix):
+            rest = local_name[len(prefix) :]
+            if not rest[:1].islower():
+                return role
+    return None
+
+
+def _parse_value_spec(
+    raw_line: str,
+    masked_line: str,
+    strings_masked_line: str,
+    name_end: int,
+) -> _ValueSpec:
+    """Split the text after a spec's first name into its type and first initializer.
+
+    Comments are dropped using the two masks (they differ only inside
+    comments). An initializer continued on a later line is not captured.
+    """
+    code = "".join(
+        raw if kept == masked else " "
+        for raw, kept, masked in zip(raw_line, strings_masked_line, masked_line, strict=False)
+    )
+    extra_names = _MORE_NAMES_RE.match(masked_line[name_end:])
+    start = name_end + (extra_names.end() if extra_names is not None else 0)
+    masked_rest = masked_line[start:]
+    equals = masked_rest.find("=")
+    type_text = (masked_rest if equals < 0 else masked_rest[:equals]).strip()
+    type_name = " ".join(type_text.split()) or None
+    if equals < 0:
+        return _ValueSpec(type_name=type_name, expression=None)
+    value_start = start + equals + 1
+    expression = _first_initializer(code[value_start:], masked_line[value_start:])
+    return _ValueSpec(type_name=type_name, expression=expression)
+
+
+def _first_initializer(code: str, masked: str) -> str | None:
+    depth = 0
+    end = len(masked)
+    for position, char in enumerate(masked):
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+        elif char == "," and depth == 0:
+            end = position
+            break
+    if depth > 0:
+        return None
+    expression = code[:end].strip()
+    return expression or None
+
+
+def _resolve_value(
+    decl_kind: str,
+    spec: _ValueSpec,
+    previous: _ValueSpec | None,
+    iota: int,
+) -> tuple[str | None, str | None, int | None]:
+    """Return `value`, `value_type`, and `iota_value` for one const or var spec.
+
+    A const spec without type or initializer repeats the previous spec of its
+    group, as in Go. `iota_value` is set for const expressions that use
+    `iota` and reduce to an integer.
+    """
+    if decl_kind == "const" and spec.expression is None and spec.type_name is None:
+        spec = previous if previous is not None else spec
+    expression = spec.expression
+    iota_value: int | None = None
+    if decl_kind == "const" and expression is not None and _IOTA_RE.search(expression):
+        iota_value = _evaluate_int(_IOTA_RE.sub(str(iota), expression))
+    value_type = spec.type_name
+    if value_type is None and expression is not None:
+        value_type = "int" if iota_value is not None else _literal_type(expression)
+    return expression, value_type, iota_value
+
+
+def _literal_type(expression: str) -> str | None:
+    """Return the default type of an untyped literal or composite literal expression."""
+    literal = expression.removeprefix("-").removeprefix("+").strip()
+    if literal.startswith(('"', "`")):
+        return "string"
+    if literal.startswith("'"):
+        return "rune"
+    if literal in {"true", "false"}:
+        return "bool"
+    if _IMAGINARY_LITERAL_RE.match(literal):
+        return "complex128"
+    if _INT_LITERAL_RE.match(literal):
+        return "int"
+    if _FLOAT_LITERAL_RE.match(literal):
+        return "float64"
+    composite = _COMPOSITE_LITERAL_RE.match(expression)
+    if composite is not None:
+        return f"{'*' if composite.group(1) else ''}{composite.group(2)}"
+    if _evaluate_int(expression) is not None:
+        return "int"
+    return None
+
+
+def _evaluate_int(expression: str) -> int | None:
+    """Evaluate a constant integer expression with Go operator semantics, or return None."""
+    if "&^" in expression:
+        return None
+    try:
+        tree = ast.parse(expression.replace("_", ""), mode="eval")
+    except SyntaxError:
+        return None
+    return _evaluate_int_node(tree.body)
+
+
+def _evaluate_int_node(node: ast.expr) -> int | None:
+    if isinstance(node, ast.Constant):
+        value = node.value
+        return value if isinstance(value, int) and not isinstance(value, bool) else None
+    if isinstance(node, ast.UnaryOp):
+        operand = _evaluate_int_node(node.operand)
+        if operand is None:
+            return None
+        if isinstance(node.op, ast.USub):
+            return -operand
+        if isinstance(node.op, ast.UAdd):
+            return operand
+        if isinstance(node.op, ast.Invert):
+            return ~operand
+        return None
+    if isinstance(node, ast.BinOp):
+        left = _evaluate_int_node(node.left)
+        right = _evaluate_int_node(node.right)
+        if left is None or right is None:
+            return None
+        return _apply_int_operator(node.op, left, right)
+    return None
+
+
+def _apply_int_operator(operator: ast.operator, left: int, right: int) -> int | None:
+    if isinstance(operator, ast.Add):
+        return left + right
+    if isinstance(operator, ast.Sub):
+        return left - right
+    if isinstance(operator, ast.Mult):
+        return left * right
+    if isinstance(operator, ast.Div | ast.Mod):
+        if right == 0:
+            return None
+        quotient = abs(left) // abs(right) * (1 if (left >= 0) == (right >= 0) else -1)
+        return quotient if isinstance(operator, ast.Div) else left - quotient * right
+    if isinstance(operator, ast.LShift | ast.RShift):
+        if right < 0 or right > _MAX_SHIFT:
+            return None
+        return left << right if isinstance(operator, ast.LShift) else left >> right
+    if isinstance(operator, ast.BitAnd):
+        return left & right
+    if isinstance(operator, ast.BitOr):
+        return left | right
+    if isinstance(operator, ast.BitXor):
+        return left ^ right
+    return None
+
+
+def _deprecation_note(doc: str | None) -> str | None:
+    """Return the text of a `Deprecated:` paragraph in doc, or None when there is none.
+
+    The note runs from the marker to the end of its paragraph, with line breaks
+    collapsed to single spaces; a bare marker yields an empty note.
+    """
+    if doc is None:
+        return None
+    for paragraph in _DOC_PARAGRAPH_RE.split(doc):
+        stripped = paragraph.strip()
+        if stripped.startswith(_DEPRECATED_MARKER):
+            return " ".join(stripped[len(_DEPRECATED_MARKER) :].split())
+    return None
+
+
+def _go_visibility(name: str) -> str:
+    local_name = name.rsplit(".", 1)[-1]
+    return "public" if local_name[:1].isupper() else "private"
+
+
 def _qualify(package_name: str | None, name: str) -> str:
     if package_name is None:
         return name
@@ -0,0 +1,213 @@
+"""Go build constraint extraction and evaluation for lexical Go scans."""
+
+from __future__ import annotations
+
+import platform
+import re
+import sys
+from collections.abc import Sequence
+
+from repo_mcp.config import GO_ARCH_VALUES, GO_OS_VALUES
+
+_UNIX_OS_VALUES = frozenset(
+    {
+        "aix",
+        "android",
+        "darwin",
+        "dragonfly",
+        "freebsd",
+        "hurd",
+        "illumos",
+        "ios",
+        "linux",
+        "netbsd",
+        "openbsd",
+        "solaris",
+    }
+)
+_IMPLIED_OS = {"android": "linux", "illumos": "solaris", "ios": "darwin"}
+_HOST_ARCH_ALIASES = {
+    "x86_64": "amd64",
+    "amd64": "amd64",
+    "aarch64": "arm64",
+    "arm64": "arm64",
+    "i386": "386",
+    "i686": "386",
+    "x86": "386",
+    "armv6l": "arm",
+    "armv7l": "arm",
+    "ppc64le": "ppc64le",
+    "s390x": "s390x",
+    "riscv64": "riscv64",
+    "loongarch64": "loong64",
+}
+_GO_BUILD_RE = re.compile(r"^//go:build\s+(?P<expr>.+?)\s*$")
+_PLUS_BUILD_RE = re.compile(r"^//\s*\+build\s+(?P<expr>.+?)\s*$")
+_TOKEN_RE = re.compile(r"\s*(\|\||&&|!|\(|\)|[A-Za-z0-9_.]+)")
+_RELEASE_TAG_RE = re.compile(r"^go1\.\d+$")
+
+
+def go_file_constraints(path: str, lines: Sequence[str]) -> tuple[str, ...]:
+    """Return the build constraints that apply to one Go file.
+
+    Filename-implied tags (`_GOOS`, `_GOARCH`, `_GOOS_GOARCH` suffixes) come
+    first, followed by the file's `//go:build` expression. Legacy `// +build`
+    lines are converted to the same expression syntax and only used when no
+    `//go:build` line is present. Each entry must hold for the file to build.
+    """
+    constraints = list(_filename_tags(path))
+    header = _header_expression(lines)
+    if header is not None:
+        constraints.append(header)
+    return tuple(constraints)
+
+
+def go_constraints_match(constraints: Sequence[str], goos: str, goarch: str) -> bool:
+    """Return True when every constraint holds for the goos/goarch target.
+
+    Known tags are the OS and architecture (including `unix` and the Go
+    toolchain's OS implications), `gc`, and `go1.N` release tags. Every other
+    tag, such as `cgo` or a custom build tag, is false. A constraint that
+    cannot be parsed is treated as satisfied so the file is kept.
+    """
+    for expression in constraints:
+        try:
+            if not _evaluate(expression, goos, goarch):
+                return False
+        except ValueError:
+            continue
+    return True
+
+
+def resolve_go_target(goos: str | None, goarch: str | None) -> tuple[str, str] | None:
+    """Return the (goos, goarch) scan target, or None when neither is configured.
+
+    When only one value is configured the other defaults to the host platform.
+    """
+    if goos is None and goarch is None:
+        return None
+    return (goos or _host_goos(), goarch or _host_goarch())
+
+
+def _filename_tags(path: str) -> tuple[str, ...]:
+    stem = path.rsplit("/", 1)[-1]
+    if stem.lower().endswith(".go"):
+        stem = stem[:-3]
+    if stem.endswith("_test"):
+        stem = stem[: -len("_test")]
+    parts = stem.split("_")
+    if len(parts) >= 3 and parts[-2] in GO_OS_VALUES and parts[-1] in GO_ARCH_VALUES:
+        return (parts[-2], parts[-1])
+    if len(parts) >= 2 and (parts[-1] in GO_OS_VALUES or parts[-1] in GO_ARCH_VALUES):
+        return (parts[-1],)
+    return ()
+
+
+def _header_expression(lines: Sequence[str]) -> str | None:
+    go_build: str | None = None
+    plus_build: list[str] = []
+    for line in lines:
+        stripped = line.strip()
+        if not stripped:
+            continue
+        if not stripped.startswith("//"):
+            break
+        go_build_match = _GO_BUILD_RE.match(stripped)
+        if go_build_match is not None and go_build is None:
+            go_build = " ".join(go_build_match.group("expr").split())
+            continue
+        plus_build_match = _PLUS_BUILD_RE.match(stripped)
+        if plus_build_match is not None:
+            plus_build.append(plus_build_match.group("expr"))
+    if go_build is not None:
+        return go_build
+    if not plus_build:
+        return None
+    converted = [_plus_build_expression(line) for line in plus_build]
+    if len(converted) == 1:
+        return converted[0]
+    return " && ".join(f"({item})" if "||" in item else item for item in converted)
+
+
+def _plus_build_expression(line: str) -> str:
+    options = [" && ".join(option.split(",")) for option in line.split()]
+    return " || ".join(options)
+
+
+def _evaluate(expression: str, goos: str, goarch: str) -> bool:
+    tokens = _tokenize(expression)
+    position, value = _parse_or(tokens, 0, goos, goarch)
+    if position != len(tokens):
+        raise ValueError(f"unexpected token {tokens[position]!r}")
+    return value
+
+
+def _tokenize(expression: str) -> list[str]:
+    tokens: list[str] = []
+    position = 0
+    stripped = expression.rstrip()
+    while position < len(stripped):
+        match = _TOKEN_RE.match(stripped, position)
+        if match is None:
+            raise ValueError(f"invalid character at offset {position}")
+        tokens.append(match.group(1))
+        position = match.end()
+    if not tokens:
+        raise ValueError("empty build constraint")
+    return tokens
+
+
+def _parse_or(tokens: list[str], position: int, goos: str, goarch: str) -> tuple[int, bool]:
+    position, value = _parse_and(tokens, position, goos, goarch)
+    while position < len(tokens) and tokens[position] == "||":
+        position, right = _parse_and(tokens, position + 1, goos, goarch)
+        value = value or right
+    return position, value
+
+
+def _parse_and(tokens: list[str], position: int, goos: str, goarch: str) -> tuple[int, bool]:
+    position, value = _parse_not(tokens, position, goos, goarch)
+    while position < len(tokens) and tokens[position] == "&&":
+        position, right = _parse_not(tokens, position + 1, goos, goarch)
+        value = value and right
+    return position, value
+
+
+def _parse_not(tokens: list[str], position: int, goos: str, goarch: str) -> tuple[int, bool]:
+    if position >= len(tokens):
+        raise ValueError("unexpected end of build constraint")
+    token = tokens[position]
+    if token == "!":
+        position, value = _parse_not(tokens, position + 1, goos, goarch)
+        return position, not value
+    if token == "(":
+        position, value = _parse_or(tokens, position + 1, goos, goarch)
+        if position >= len(tokens) or tokens[position] != ")":
+            raise ValueError("unbalanced parentheses in build constraint")
+        return position + 1, value
+    if token in {"||", "&&", ")"}:
+        raise ValueError(f"unexpected token {token!r}")
+    return position + 1, _tag_matches(token, goos, goarch)
+
+
+def _tag_matches(tag: str, goos: str, goarch: str) -> bool:
+    if tag in {goos, goarch, "gc"}:
+        return True
+    if tag == "unix":
+        return goos in _UNIX_OS_VALUES
+    if _IMPLIED_OS.get(goos) == tag:
+        return True
+    return _RELEASE_TAG_RE.match(tag) is not None
+
+
+def _host_goos() -> str:
+    if sys.platform.startswith("win") or sys.platform == "cygwin":
+        return "windows"
+    for name in GO_OS_VALUES:
+        if sys.platform.startswith(name):
+            return name
+    return "linux"
+
+
+def _host_goarch() -> str:
+    return _HOST_ARCH_ALIASES.get(platform.machine().lower(), "amd64")
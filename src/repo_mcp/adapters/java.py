@@ -3,10 +3,21 @@
 from __future__ import annotations
 
 import re
-from dataclasses import dataclass
+from dataclasses import dataclass, replace
 
-from repo_mcp.adapters.base import OutlineSymbol, SymbolReference, normalize_and_sort_symbols
+from repo_mcp.adapters.base import (
+    FileImport,
+    OutlineSymbol,
+    ParseDiagnostic,
+    SymbolReference,
+    assign_package,
+    assign_start_columns,
+    mark_deprecation,
+    normalize_and_sort_diagnostics,
+    normalize_and_sort_symbols,
+)
 from repo_mcp.adapters.lexical import (
+    brace_balance_diagnostics,
     mask_comments_and_strings,
     references_for_symbol_lexical,
     references_for_symbols_lexical,
@@ -14,24 +25,52 @@ from repo_mcp.adapters.lexical import (
 )
 
 _PACKAGE_RE = re.compile(r"^\s*package\s+([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*)\s*;")
+_ANNOTATION = r"@(?!interface\b)[A-Za-z_][A-Za-z0-9_.]*(?:\s*\([^)]*\))?"
+_ANNOTATION_RE = re.compile(_ANNOTATION)
+_ANNOTATION_LINE_RE = re.compile(rf"^\s*(?:{_ANNOTATION}\s*)+$")
+_ANNOTATIONS_PREFIX = rf"(?P<annotations>(?:{_ANNOTATION}\s*)*)"
 _TYPE_RE = re.compile(
-    r"^\s*(?:(?:public|protected|private|abstract|final|static|sealed|non-sealed|strictfp)\s+)*"
-    r"(class|interface|enum|record)\s+([A-Za-z_][A-Za-z0-9_]*)\b"
+    rf"^\s*{_ANNOTATIONS_PREFIX}"
+    r"(?P<modifiers>(?:(?:public|protected|private|abstract|final|static|sealed|non-sealed|"
+    r"strictfp)\s+)*)"
+    r"(?P<kind>class|interface|enum|record)\s+(?P<name>[A-Za-z_][A-Za-z0-9_]*)\b"
 )
 _METHOD_RE = re.compile(
-    r"^\s*(?:@[A-Za-z_][A-Za-z0-9_]*(?:\([^)]*\))?\s*)*"
-    r"(?:(?:public|protected|private|abstract|final|static|synchronized|native|strictfp|default)\s+)*"
-    r"(?:(?:<[^>]+>\s*)?([A-Za-z_][A-Za-z0-9_<>\[\], ?.]*?)\s+)?"
-    r"([A-Za-z_][A-Za-z0-9_]*)\s*\(([^)]*)\)\s*"
-    r"(?:throws\s+[A-Za-z0-9_.,\s]+)?\s*([;{])"
+    rf"^\s*{_ANNOTATIONS_PREFIX}"
+    r"(?P<modifiers>(?:(?:public|protected|private|abstract|final|static|synchronized|native|"
+    r"strictfp|default)\s+)*)"
+    r"(?:(?:<[^>]+>\s*)?(?P<returns>[A-Za-z_][A-Za-z0-9_<>\[\], ?.]*?)\s+)?"
+    r"(?P<name>[A-Za-z_][A-Za-z0-9_]*)\s*\((?P<params>[^)]*)\)\s*"
+    r"(?:throws\s+[A-Za-z0-9_.,\s]+)?\s*(?P<terminator>[;{])"
+)
+_FIELD_RE = re.compile(
+    rf"^\s*{_ANNOTATIONS_PREFIX}"
+    r"(?P<modifiers>(?:(?:public|protected|private|static|final|transient|volatile)\s+)*)"
+    r"(?P<type>[A-Za-z_][A-Za-z0-9_.]*(?:\s*<[A-Za-z0-9_<>\[\], ?.&]*>)?(?:\s*\[\s*\])*)\s+"
+    r"(?P<name>[A-Za-z_][A-Za-z0-9_]*)\s*(?:\[\s*\]\s*)*[=;,]"
 )
+_DECLARATOR_NAME_RE = re.compile(r"^\s*([A-Za-z_][A-Za-z0-9_]*)")
 _METHOD_SKIP = {"if", "for", "while", "switch", "catch", "return", "new"}
+_FIELD_SKIP_TYPES = {
+    "assert",
+    "case",
+    "else",
+    "import",
+    "new",
+    "package",
+    "return",
+    "throw",
+    "yield",
+}
+_ACCESS_MODIFIERS = ("public", "protected", "private")
+_IMPLICITLY_PUBLIC_OWNERS = frozenset({"interface"})
 
 
 @dataclass(slots=True, frozen=True)
 class _JavaTypeBlock:
     name: str
     qualified_name: str
+    kind: str
     start_line: int
     end_line: int
     depth: int
@@ -47,10 +86,11 @@ class JavaLexicalAdapter:
         return path.lower().endswith(".java")
 
     def outline(self, path: str, text: str) -> list[OutlineSymbol]:
-        """Extract package-aware top-level types and class members."""
+        """Extract package-aware types, nested types, and their methods and fields."""
         _ = path
         masked = mask_comments_and_strings(text)
         lines = masked.splitlines()
+        raw_lines = text.splitlines()
         depth_before = _line_depths(masked)
         block_ends = _block_end_by_start_line(masked)
         package_name = _find_package(lines)
@@ -60,35 +100,48 @@ class JavaLexicalAdapter:
 
         for index, line in enumerate(lines):
             line_number = index + 1
-            if depth_before[index] != 0:
-                continue
             type_match = _TYPE_RE.match(line)
             if type_match is None:
                 continue
+            depth = depth_before[index]
+            owner = _enclosing_type(type_blocks, line_number, depth)
+            if depth != 0 and owner is None:
+                continue
 
-            kind, type_name = type_match.group(1), type_match.group(2)
-            qualified_name = (
-                f"{package_name}.{type_name}" if package_name is not None else type_name
-            )
+            kind, type_name = type_match.group("kind"), type_match.group("name")
+            if owner is not None:
+                qualified_name = f"{owner.qualified_name}.{type_name}"
+            elif package_name is not None:
+                qualified_name = f"{package_name}.{type_name}"
+            else:
+                qualified_name = type_name
             end_line = block_ends.get(line_number, line_number)
-            symbol_kind = "type" if kind == "record" else kind
+            access = _java_access(type_match.group("modifiers"), owner)
             symbols.append(
-                OutlineSymbol(
-                    kind=symbol_kind,
-                    name=qualified_name,
-                    signature="()",
-                    start_line=line_number,
-                    end_line=end_line,
-                    doc=None,
+                _with_annotations(
+                    OutlineSymbol(
+                        kind="type" if kind == "record" else kind,
+                        name=qualified_name,
+                        signature="()",
+                        start_line=line_number,
+                        end_line=end_line,
+                        doc=None,
+                        parent_symbol=owner.qualified_name if owner is not None else None,
+                        scope_kind="class" if owner is not None else None,
+                        visibility=_java_visibility(access),
+                        access=access,
+                    ),
+                    _annotations(raw_lines, lines, index, type_match),
                 )
             )
             type_blocks.append(
                 _JavaTypeBlock(
                     name=type_name,
                     qualified_name=qualified_name,
+                    kind=kind,
                     start_line=line_number,
                     end_line=end_line,
-                    depth=1,
+                    depth=depth + 1,
                 )
             )
 
@@ -96,13 +149,17 @@ class JavaLexicalAdapter:
             symbols.extend(
                 _extract_type_members(
                     lines=lines,
+                    raw_lines=raw_lines,
                     depth_before=depth_before,
+                    paren_before=_paren_depths(lines),
                     block_ends=block_ends,
                     type_block=type_block,
                 )
             )
 
-        return normalize_and_sort_symbols(symbols)
+        return normalize_and_sort_symbols(
+            assign_package(assign_start_columns(symbols, text), package_name, prefix_names=False)
+        )
 
     def smart_chunks(self, path: str, text: str) -> list[tuple[int, int]] | None:
         """Java adapter does not provide smart chunk ranges in v1."""
@@ -115,6 +172,18 @@ class JavaLexicalAdapter:
         _ = prompt
         return ()
 
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """Java adapter does not extract imports in v1."""
+        _ = path
+        _ = text
+        return None
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Report unbalanced braces outside comments and strings."""
+        _ = path
+        masked = mask_comments_and_strings(text)
+        return normalize_and_sort_diagnostics(brace_balance_diagnostics(masked))
+
     def references_for_symbol(
         self,
         symbol: str,
@@ -176,9 +245,99 @@ def _block_end_by_start_line(masked_text: str) -> dict[int, int]:
     return mapping
 
 
+def _paren_depths(lines: list[str]) -> list[int]:
+    depths: list[int] = []
+    depth = 0
+    for line in lines:
+        depths.append(depth)
+        depth = max(0, depth + line.count("(") - line.count(")"))
+    return depths
+
+
+def _enclosing_type(
+    type_blocks: list[_JavaTypeBlock], line_number: int, depth: int
+) -> _JavaTypeBlock | None:
+    for block in reversed(type_blocks):
+        if block.start_line < line_number <= block.end_line and block.depth == depth:
+            return block
+    return None
+
+
+def _java_access(modifiers: str, owner: _JavaTypeBlock | None) -> str:
+    """Return the declared access level, applying Java's implicit defaults."""
+    words = modifiers.split()
+    for access in _ACCESS_MODIFIERS:
+        if access in words:
+            return access
+    if owner is not None and owner.kind in _IMPLICITLY_PUBLIC_OWNERS:
+        return "public"
+    return "package-private"
+
+
+def _java_visibility(access: str) -> str:
+    return "public" if access == "public" else "private"
+
+
+def _annotations(
+    raw_lines: list[str],
+    lines: list[str],
+    index: int,
+    matched: re.Match[str],
+) -> tuple[str, ...]:
+    """Return annotations on the declaration line and annotation-only lines above it.
+
+    Each annotation keeps its arguments as written, without the leading `@`.
+    """
+    rows: list[tuple[str, str, int]] = []
+    above = index - 1
+    while above >= 0 and _ANNOTATION_LINE_RE.match(lines[above]):
+        rows.append((raw_lines[above], lines[above], len(lines[above])))
+        above -= 1
+    rows.reverse()
+    rows.append((raw_lines[index], lines[index], matched.end("annotations")))
+    annotations: list[str] = []
+    for raw_line, masked_line, end in rows:
+        for annotation in _ANNOTATION_RE.finditer(masked_line, 0, end):
+            written = raw_line[annotation.start() + 1 : annotation.end()]
+            annotations.append(" ".join(written.split()))
+    return tuple(annotations)
+
+
+def _with_annotations(symbol: OutlineSymbol, annotations: tuple[str, ...]) -> OutlineSymbol:
+    symbol = replace(symbol, decorators=annotations or None)
+    deprecated = any(
+        annotation.split("(", 1)[0].strip() in {"Deprecated", "java.lang.Deprecated"}
+        for annotation in annotations
+    )
+    return mark_deprecation(symbol, "" if deprecated else None)
+
+
+def _declarator_names(masked_line: str, start: int) -> list[str]:
+    """Return the variable names declared from start to the end of a field line."""
+    names: list[str] = []
+    depth = 0
+    piece_start = start
+    for position in range(start, len(masked_line) + 1):
+        char = masked_line[position] if position < len(masked_line) else ";"
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+        elif depth == 0 and char in ",;":
+            name = _DECLARATOR_NAME_RE.match(masked_line[piece_start:position])
+            if name is not None:
+                names.append(name.group(1))
+            if char == ";":
+                break
+            piece_start = position + 1
+    return names
+
+
 def _extract_type_members(
     lines: list[str],
+    raw_lines: list[str],
     depth_before: list[int],
+    paren_before: list[int],
     block_ends: dict[int, int],
     type_block: _JavaTypeBlock,
 ) -> list[OutlineSymbol]:
@@ -186,38 +345,74 @@ def _extract_type_members(
     start = max(1, type_block.start_line + 1)
     end = min(type_block.end_line, len(lines))
     for line_number in range(start, end + 1):
-        line = lines[line_number - 1]
-        if depth_before[line_number - 1] != type_block.depth:
+        index = line_number - 1
+        line = lines[index]
+        if depth_before[index] != type_block.depth or paren_before[index] != 0:
             continue
-        matched = _METHOD_RE.match(line)
-        if matched is None:
+        if _TYPE_RE.match(line) is not None:
             continue
-
-        return_type, member_name, params, terminator = (
-            matched.group(1),
-            matched.group(2),
-            matched.group(3).strip(),
-            matched.group(4),
-        )
-        if member_name in _METHOD_SKIP:
+        matched = _METHOD_RE.match(line)
+        if matched is not None:
+            method = _method_symbol(matched, line_number, block_ends, type_block)
+            if method is not None:
+                symbols.append(
+                    _with_annotations(method, _annotations(raw_lines, lines, index, matched))
+                )
             continue
-
-        if member_name == type_block.name:
-            kind = "constructor"
-        elif return_type is None:
+        field = _FIELD_RE.match(line)
+        if field is None or field.group("type") in _FIELD_SKIP_TYPES:
             continue
-        else:
-            kind = "method"
-
-        symbol_end = line_number if terminator == ";" else block_ends.get(line_number, line_number)
-        symbols.append(
-            OutlineSymbol(
-                kind=kind,
-                name=f"{type_block.qualified_name}.{member_name}",
-                signature=f"({params})",
-                start_line=line_number,
-                end_line=max(line_number, symbol_end),
-                doc=None,
+        access = _java_access(field.group("modifiers"), type_block)
+        annotations = _annotations(raw_lines, lines, index, field)
+        field_type = " ".join(field.group("type").split())
+        for name in _declarator_names(line, field.start("name")):
+            symbols.append(
+                _with_annotations(
+                    OutlineSymbol(
+                        kind="field",
+                        name=f"{type_block.qualified_name}.{name}",
+                        signature=field_type,
+                        start_line=line_number,
+                        end_line=line_number,
+                        doc=None,
+                        parent_symbol=type_block.qualified_name,
+                        scope_kind="class",
+                        visibility=_java_visibility(access),
+                        access=access,
+                    ),
+                    annotations,
+                )
             )
-        )
     return symbols
+
+
+def _method_symbol(
+    matched: re.Match[str],
+    line_number: int,
+    block_ends: dict[int, int],
+    type_block: _JavaTypeBlock,
+) -> OutlineSymbol | None:
+    member_name = matched.group("name")
+    if member_name in _METHOD_SKIP:
+        return None
+    if member_name == type_block.name:
+        kind = "constructor"
+    elif matched.group("returns") is None:
+        return None
+    else:
+        kind = "method"
+    access = _java_access(matched.group("modifiers"), type_block)
+    if kind == "constructor" and type_block.kind == "enum":
+        access = "private"
+    terminator = matched.group("terminator")
+    symbol_end = line_number if terminator == ";" else block_ends.get(line_number, line_number)
+    return OutlineSymbol(
+        kind=kind,
+        name=f"{type_block.qualified_name}.{member_name}",
+        signature=f"({matched.group('params').strip()})",
+        start_line=line_number,
+        end_line=max(line_number, symbol_end),
+        doc=None,
+        visibility=_java_visibility(access),
+        access=access,
+    )
@@ -0,0 +1,593 @@
+"""Lexical Kotlin adapter for deterministic symbol outlining."""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass, replace
+
+from repo_mcp.adapters.base import (
+    FileImport,
+    OutlineSymbol,
+    ParseDiagnostic,
+    SymbolReference,
+    assign_package,
+    assign_start_columns,
+    mark_deprecation,
+    normalize_and_sort_diagnostics,
+    normalize_and_sort_symbols,
+)
+from repo_mcp.adapters.lexical import (
+    LexicalRules,
+    brace_balance_diagnostics,
+    mask_comments_and_strings,
+    references_for_symbol_lexical,
+    references_for_symbols_lexical,
+    scan_brace_blocks,
+)
+
+_KOTLIN_RULES = LexicalRules(line_comment_prefixes=("//",), string_delimiters=('"""', '"', "'"))
+_IDENTIFIER = r"[A-Za-z_][A-Za-z0-9_]*"
+_PACKAGE_RE = re.compile(rf"^\s*package\s+({_IDENTIFIER}(?:\.{_IDENTIFIER})*)\s*;?\s*$")
+_ANNOTATION = r"@(?:[A-Za-z]+:)?[A-Za-z_][A-Za-z0-9_.]*(?:\s*\([^)]*\))?"
+_ANNOTATION_RE = re.compile(_ANNOTATION)
+_ANNOTATION_LINE_RE = re.compile(rf"^\s*(?:{_ANNOTATION}\s*)+$")
+_ANNOTATIONS_PREFIX = rf"(?P<annotations>(?:{_ANNOTATION}\s*)*)"
+_MODIFIERS = (
+    r"(?P<modifiers>(?:(?:public|protected|private|internal|abstract|final|open|sealed|data|"
+    r"enum|annotation|inner|value|companion|override|suspend|inline|tailrec|operator|infix|"
+    r"external|const|lateinit|expect|actual)\s+)*)"
+)
+_RECEIVER = (
+    rf"(?P<receiver>{_IDENTIFIER}(?:\s*<[^()=]*?>)?\??"
+    rf"(?:\.{_IDENTIFIER}(?:\s*<[^()=]*?>)?\??)*)\s*\."
+)
+_TYPE_RE = re.compile(
+    rf"^\s*{_ANNOTATIONS_PREFIX}{_MODIFIERS}"
+    rf"(?P<kind>(?:fun\s+)?interface|class|object)\b(?:\s+(?P<name>{_IDENTIFIER}))?"
+)
+_CONSTRUCTOR_RE = re.compile(
+    rf"\s*(?:<[^(){{}}]*>)?\s*(?:{_ANNOTATION}\s*)*"
+    r"(?:(?:public|protected|private|internal)\s+)?(?:constructor\s*)?\("
+)
+_FUNCTION_RE = re.compile(
+    rf"^\s*{_ANNOTATIONS_PREFIX}{_MODIFIERS}fun\s+(?:<[^()]*?>\s*)?(?:{_RECEIVER})?"
+    rf"(?P<name>{_IDENTIFIER})\s*\("
+)
+_FUNCTION_TAIL_RE = re.compile(
+    r"\s*(?::\s*(?P<returns>[^={\n]+?))?\s*(?:where\b[^={\n]*)?(?:[={]|$)", re.MULTILINE
+)
+_PROPERTY_RE = re.compile(
+    rf"^\s*{_ANNOTATIONS_PREFIX}{_MODIFIERS}(?P<binding>val|var)\s+(?:<[^()]*?>\s*)?"
+    rf"(?:{_RECEIVER})?(?P<name>{_IDENTIFIER})\b\s*(?::\s*(?P<type>[^={{]*?))?"
+    r"\s*(?:=|\bby\b|\{|$)"
+)
+_PARAMETER_PROPERTY_RE = re.compile(
+    rf"^\s*(?:{_ANNOTATION}\s*)*"
+    r"(?P<modifiers>(?:(?:public|protected|private|internal|override|open|final)\s+)*)"
+    rf"(?P<binding>val|var)\s+(?P<name>{_IDENTIFIER})\s*:\s*(?P<type>.+)$",
+    re.DOTALL,
+)
+_ACCESSOR_RE = re.compile(
+    rf"^\s*(?:{_ANNOTATION}\s*)*(?:(?P<access>public|protected|private|internal)\s+)?"
+    r"(?P<accessor>get|set)(?![A-Za-z0-9_])"
+)
+_DEPRECATION_NOTE_RE = re.compile(r'^\(\s*(?:message\s*=\s*)?"((?:[^"\\]|\\.)*)"')
+_DEPRECATED_ANNOTATIONS = frozenset({"Deprecated", "kotlin.Deprecated"})
+_ACCESS_MODIFIERS = ("public", "protected", "internal", "private")
+_DEFAULT_COMPANION_NAME = "Companion"
+
+
+@dataclass(slots=True, frozen=True)
+class _KotlinTypeBlock:
+    qualified_name: str
+    start_line: int
+    end_line: int
+    depth: int
+
+
+class KotlinLexicalAdapter:
+    """Deterministic lexical adapter for Kotlin source files."""
+
+    name = "kotlin_lexical"
+
+    def supports_path(self, path: str) -> bool:
+        """Return True when path is a Kotlin source file."""
+        return path.lower().endswith(".kt")
+
+    def outline(self, path: str, text: str) -> list[OutlineSymbol]:
+        """Extract package-aware types, objects, functions, and properties.
+
+        Classes (including data and sealed classes), interfaces, objects, and
+        companion objects nest by name under their enclosing type. Extension
+        functions and properties record their receiver type, properties report
+        `val`/`var` mutability through `accessors`, and visibility modifiers
+        populate `access` and `visibility`.
+        """
+        _ = path
+        masked = mask_comments_and_strings(text, _KOTLIN_RULES)
+        lines = masked.splitlines()
+        raw_lines = text.splitlines()
+        line_offsets = _line_offsets(masked)
+        depth_before = _line_depths(masked)
+        paren_before = _paren_depths(lines)
+        block_ends = _block_end_by_start_line(masked)
+        package_name = _find_package(lines)
+
+        symbols: list[OutlineSymbol] = []
+        type_blocks: list[_KotlinTypeBlock] = []
+
+        for index, line in enumerate(lines):
+            line_number = index + 1
+            depth = depth_before[index]
+            if paren_before[index] != 0:
+                continue
+            owner = _enclosing_type(type_blocks, line_number, depth)
+            if depth != 0 and owner is None:
+                continue
+
+            type_match = _TYPE_RE.match(line)
+            if type_match is not None:
+                found = _type_symbols(
+                    type_match,
+                    masked=masked,
+                    lines=lines,
+                    raw_lines=raw_lines,
+                    line_offsets=line_offsets,
+                    block_ends=block_ends,
+                    index=index,
+                    owner=owner,
+                    package_name=package_name,
+                )
+                if found is not None:
+                    type_block, type_symbols = found
+                    type_blocks.append(replace(type_block, depth=depth + 1))
+                    symbols.extend(type_symbols)
+                continue
+
+            function_match = _FUNCTION_RE.match(line)
+            if function_match is not None:
+                symbols.append(
+                    _with_annotations(
+                        _function_symbol(
+                            function_match,
+                            masked=masked,
+                            lines=lines,
+                            line_offsets=line_offsets,
+                            block_ends=block_ends,
+                            index=index,
+                            owner=owner,
+                            package_name=package_name,
+                        ),
+                        _annotations(raw_lines, lines, index, function_match),
+                    )
+                )
+                continue
+
+            property_match = _PROPERTY_RE.match(line)
+            if property_match is not None:
+                symbols.append(
+                    _with_annotations(
+                        _property_symbol(
+                            property_match,
+                            lines=lines,
+                            depth_before=depth_before,
+                            block_ends=block_ends,
+                            index=index,
+                            owner=owner,
+                            package_name=package_name,
+                        ),
+                        _annotations(raw_lines, lines, index, property_match),
+                    )
+                )
+
+        return normalize_and_sort_symbols(
+            assign_package(assign_start_columns(symbols, text), package_name, prefix_names=False)
+        )
+
+    def smart_chunks(self, path: str, text: str) -> list[tuple[int, int]] | None:
+        """Kotlin adapter does not provide smart chunk ranges in v1."""
+        _ = path
+        _ = text
+        return None
+
+    def symbol_hints(self, prompt: str) -> tuple[str, ...]:
+        """Kotlin adapter does not derive symbol hints in v1."""
+        _ = prompt
+        return ()
+
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """Kotlin adapter does not extract imports in v1."""
+        _ = path
+        _ = text
+        return None
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Report unbalanced braces outside comments and strings."""
+        _ = path
+        masked = mask_comments_and_strings(text, _KOTLIN_RULES)
+        return normalize_and_sort_diagnostics(brace_balance_diagnostics(masked))
+
+    def references_for_symbol(
+        self,
+        symbol: str,
+        files: list[tuple[str, str]],
+        *,
+        top_k: int | None = None,
+    ) -> list[SymbolReference]:
+        """Return deterministic lexical references for one symbol in Kotlin files."""
+        return references_for_symbol_lexical(
+            symbol=symbol,
+            files=files,
+            supports_path=self.supports_path,
+            top_k=top_k,
+        )
+
+    def references_for_symbols(
+        self,
+        symbols: list[str],
+        files: list[tuple[str, str]],
+        *,
+        top_k: int | None = None,
+    ) -> dict[str, list[SymbolReference]]:
+        """Return deterministic lexical references for many symbols in Kotlin files."""
+        return references_for_symbols_lexical(
+            symbols=symbols,
+            files=files,
+            supports_path=self.supports_path,
+            top_k=top_k,
+        )
+
+
+def _find_package(lines: list[str]) -> str | None:
+    for line in lines:
+        matched = _PACKAGE_RE.match(line)
+        if matched is not None:
+            return matched.group(1)
+    return None
+
+
+def _line_offsets(masked_text: str) -> list[int]:
+    offsets = [0]
+    for line in masked_text.splitlines(keepends=True):
+        offsets.append(offsets[-1] + len(line))
+    return offsets
+
+
+def _line_at(line_offsets: list[int], offset: int) -> int:
+    """Return the 1-based line holding the character at offset."""
+    line = 1
+    while line < len(line_offsets) - 1 and line_offsets[line] <= offset:
+        line += 1
+    return line
+
+
+def _line_depths(masked_text: str) -> list[int]:
+    depths: list[int] = []
+    depth = 0
+    for line in masked_text.splitlines():
+        depths.append(depth)
+        for char in line:
+            if char == "{":
+                depth += 1
+            elif char == "}":
+                depth = max(0, depth - 1)
+    return depths
+
+
+def _paren_depths(lines: list[str]) -> list[int]:
+    depths: list[int] = []
+    depth = 0
+    for line in lines:
+        depths.append(depth)
+        depth = max(0, depth + line.count("(") - line.count(")"))
+    return depths
+
+
+def _block_end_by_start_line(masked_text: str) -> dict[int, int]:
+    mapping: dict[int, int] = {}
+    for block in scan_brace_blocks(masked_text).blocks:
+        existing = mapping.get(block.start_line)
+        if existing is None or block.end_line > existing:
+            mapping[block.start_line] = block.end_line
+    return mapping
+
+
+def _enclosing_type(
+    type_blocks: list[_KotlinTypeBlock], line_number: int, depth: int
+) -> _KotlinTypeBlock | None:
+    for block in reversed(type_blocks):
+        if block.start_line < line_number <= block.end_line and block.depth == depth:
+            return block
+    return None
+
+
+def _closing_paren(masked: str, open_offset: int) -> int | None:
+    """Return the offset of the `)` matching the `(` at open_offset."""
+    depth = 0
+    for position in range(open_offset, len(masked)):
+        char = masked[position]
+        if char == "(":
+            depth += 1
+        elif char == ")":
+            depth -= 1
+            if depth == 0:
+                return position
+    return None
+
+
+def _split_parameters(parameters: str) -> list[tuple[int, str]]:
+    """Split a parameter list at top-level commas into (offset, text) pieces."""
+    pieces: list[tuple[int, str]] = []
+    depth = 0
+    piece_start = 0
+    for position, char in enumerate(parameters):
+        if char in "([{<":
+            depth += 1
+        elif char in ")]}" or (char == ">" and parameters[position - 1 : position] != "-"):
+            depth -= 1
+        elif depth == 0 and char == ",":
+            pieces.append((piece_start, parameters[piece_start:position]))
+            piece_start = position + 1
+    pieces.append((piece_start, parameters[piece_start:]))
+    return [(offset, piece) for offset, piece in pieces if piece.strip()]
+
+
+def _without_default(parameter: str) -> str:
+    """Return a parameter declaration without its `= default` value."""
+    depth = 0
+    for position, char in enumerate(parameter):
+        if char in "([{<":
+            depth += 1
+        elif char in ")]}" or (char == ">" and parameter[position - 1 : position] != "-"):
+            depth -= 1
+        elif depth == 0 and char == "=":
+            return parameter[:position]
+    return parameter
+
+
+def _signature(parameters: str) -> str:
+    """Return the normalized `(...)` signature of a parameter list, defaults removed."""
+    declared = [
+        " ".join(_without_default(piece).split()) for _, piece in _split_parameters(parameters)
+    ]
+    return f"({', '.join(declared)})"
+
+
+def _kotlin_access(modifiers: str) -> str:
+    """Return the declared access level, defaulting to Kotlin's implicit `public`."""
+    words = modifiers.split()
+    for access in _ACCESS_MODIFIERS:
+        if access in words:
+            return access
+    return "public"
+
+
+def _kotlin_visibility(access: str) -> str:
+    return "public" if access == "public" else "private"
+
+
+def _qualify(name: str, owner: _KotlinTypeBlock | None, package_name: str | None) -> str:
+    if owner is not None:
+        return f"{owner.qualified_name}.{name}"
+    if package_name is not None:
+        return f"{package_name}.{name}"
+    return name
+
+
+def _member_scope(owner: _KotlinTypeBlock | None) -> dict[str, str | None]:
+    return {
+        "parent_symbol": owner.qualified_name if owner is not None else None,
+        "scope_kind": "class" if owner is not None else None,
+    }
+
+
+def _type_symbols(
+    matched: re.Match[str],
+    *,
+    masked: str,
+    lines: list[str],
+    raw_lines: list[str],
+    line_offsets: list[int],
+    block_ends: dict[int, int],
+    index: int,
+    owner: _KotlinTypeBlock | None,
+    package_name: str | None,
+) -> tuple[_KotlinTypeBlock, list[OutlineSymbol]] | None:
+    """Return the type block and symbols of a type declaration and its constructor properties."""
+    line_number = index + 1
+    modifiers = matched.group("modifiers")
+    kind = matched.group("kind").split()[-1]
+    type_name = matched.group("name")
+    if type_name is None:
+        if kind != "object" or "companion" not in modifiers.split():
+            return None
+        type_name = _DEFAULT_COMPANION_NAME
+    if kind == "class" and "enum" in modifiers.split():
+        kind = "enum"
+    qualified_name = _qualify(type_name, owner, package_name)
+
+    header_line = line_number
+    signature: str | None = None
+    parameter_symbols: list[OutlineSymbol] = []
+    name_end = line_offsets[index] + matched.end()
+    constructor = _CONSTRUCTOR_RE.match(masked, name_end) if kind != "object" else None
+    close = _closing_paren(masked, constructor.end() - 1) if constructor is not None else None
+    if constructor is not None and close is not None:
+        open_offset = constructor.end()
+        parameters = masked[open_offset:close]
+        signature = _signature(parameters)
+        header_line = _line_at(line_offsets, close)
+        for offset, piece in _split_parameters(parameters):
+            declared = _PARAMETER_PROPERTY_RE.match(_without_default(piece))
+            if declared is None:
+                continue
+            name_offset = open_offset + offset + declared.start("name")
+            access = _kotlin_access(declared.group("modifiers"))
+            parameter_symbols.append(
+                _with_annotations(
+                    OutlineSymbol(
+                    kind="property",
+                    name=f"{qualified_name}.{declared.group('name')}",
+                    signature=" ".join(declared.group("type").split()),
+                    start_line=_line_at(line_offsets, name_offset),
+                    end_line=_line_at(line_offsets, name_offset),
+                    doc=None,
+                    parent_symbol=qualified_name,
+                    scope_kind="class",
+                    visibility=_kotlin_visibility(access),
+                    access=access,
+                    accessors=_binding_accessors(declared.group("binding")),
+                    ),
+                    (),
+                )
+            )
+
+    end_line = block_ends.get(header_line, header_line)
+    access = _kotlin_access(modifiers)
+    type_symbol = _with_annotations(
+        OutlineSymbol(
+            kind=kind,
+            name=qualified_name,
+            signature=signature,
+            start_line=line_number,
+            end_line=end_line,
+            doc=None,
+            visibility=_kotlin_visibility(access),
+            access=access,
+            **_member_scope(owner),
+        ),
+        _annotations(raw_lines, lines, index, matched),
+    )
+    type_block = _KotlinTypeBlock(
+        qualified_name=qualified_name,
+        start_line=line_number,
+        end_line=end_line,
+        depth=0,
+    )
+    return type_block, [type_symbol, *parameter_symbols]
+
+
+def _function_symbol(
+    matched: re.Match[str],
+    *,
+    masked: str,
+    lines: list[str],
+    line_offsets: list[int],
+    block_ends: dict[int, int],
+    index: int,
+    owner: _KotlinTypeBlock | None,
+    package_name: str | None,
+) -> OutlineSymbol:
+    line_number = index + 1
+    open_offset = line_offsets[index] + matched.end() - 1
+    close = _closing_paren(masked, open_offset)
+    signature: str | None = None
+    returns: tuple[str, ...] | None = None
+    end_line = line_number
+    if close is not None:
+        signature = _signature(masked[open_offset + 1 : close])
+        close_line = _line_at(line_offsets, close)
+        tail = _FUNCTION_TAIL_RE.match(masked, close + 1, line_offsets[close_line])
+        if tail is not None and tail.group("returns") is not None:
+            returns = (" ".join(tail.group("returns").split()),)
+        end_line = block_ends.get(close_line, close_line)
+    receiver = matched.group("receiver")
+    access = _kotlin_access(matched.group("modifiers"))
+    return OutlineSymbol(
+        kind="method" if owner is not None else "function",
+        name=_qualify(matched.group("name"), owner, package_name),
+        signature=signature,
+        start_line=line_number,
+        end_line=max(line_number, end_line),
+        doc=None,
+        visibility=_kotlin_visibility(access),
+        returns=returns,
+        access=access,
+        receiver=" ".join(receiver.split()) if receiver is not None else None,
+        **_member_scope(owner),
+    )
+
+
+def _property_symbol(
+    matched: re.Match[str],
+    *,
+    lines: list[str],
+    depth_before: list[int],
+    block_ends: dict[int, int],
+    index: int,
+    owner: _KotlinTypeBlock | None,
+    package_name: str | None,
+) -> OutlineSymbol:
+    line_number = index + 1
+    accessors = list(_binding_accessors(matched.group("binding")))
+    end_line = block_ends.get(line_number, line_number)
+    following = end_line
+    while following < len(lines) and depth_before[following] == depth_before[index]:
+        accessor = _ACCESSOR_RE.match(lines[following])
+        if accessor is None:
+            break
+        if accessor.group("accessor") == "set" and accessor.group("access") is not None:
+            accessors = [
+                f"{accessor.group('access')} set" if item == "set" else item for item in accessors
+            ]
+        end_line = block_ends.get(following + 1, following + 1)
+        following = end_line
+    receiver = matched.group("receiver")
+    property_type = matched.group("type")
+    access = _kotlin_access(matched.group("modifiers"))
+    return OutlineSymbol(
+        kind="property",
+        name=_qualify(matched.group("name"), owner, package_name),
+        signature=" ".join(property_type.split()) if property_type else None,
+        start_line=line_number,
+        end_line=end_line,
+        doc=None,
+        visibility=_kotlin_visibility(access),
+        access=access,
+        accessors=tuple(accessors),
+        receiver=" ".join(receiver.split()) if receiver is not None else None,
+        **_member_scope(owner),
+    )
+
+
+def _binding_accessors(binding: str) -> tuple[str, ...]:
+    """Return the accessors a `val` (read-only) or `var` (mutable) property declares."""
+    return ("get", "set") if binding == "var" else ("get",)
+
+
+def _annotations(
+    raw_lines: list[str],
+    lines: list[str],
+    index: int,
+    matched: re.Match[str],
+) -> tuple[str, ...]:
+    """Return annotations on the declaration line and annotation-only lines above it.
+
+    Each annotation keeps its arguments as written, without the leading `@`.
+    """
+    rows: list[tuple[str, str, int]] = []
+    above = index - 1
+    while above >= 0 and _ANNOTATION_LINE_RE.match(lines[above]):
+        rows.append((raw_lines[above], lines[above], len(lines[above])))
+        above -= 1
+    rows.reverse()
+    rows.append((raw_lines[index], lines[index], matched.end("annotations")))
+    annotations: list[str] = []
+    for raw_line, masked_line, end in rows:
+        for annotation in _ANNOTATION_RE.finditer(masked_line, 0, end):
+            written = raw_line[annotation.start() + 1 : annotation.end()]
+            annotations.append(" ".join(written.split()))
+    return tuple(annotations)
+
+
+def _with_annotations(symbol: OutlineSymbol, annotations: tuple[str, ...]) -> OutlineSymbol:
+    symbol = replace(symbol, decorators=annotations or None)
+    note: str | None = None
+    for annotation in annotations:
+        name, _, arguments = annotation.partition("(")
+        if name.strip() not in _DEPRECATED_ANNOTATIONS:
+            continue
+        message = _DEPRECATION_NOTE_RE.match(f"({arguments}")
+        note = message.group(1) if message is not None else ""
+        break
+    return mark_deprecation(symbol, note)
@@ -6,7 +6,11 @@ import re
 from collections.abc import Callable
 from dataclasses import dataclass
 
-from repo_mcp.adapters.base import SymbolReference, normalize_and_sort_references
+from repo_mcp.adapters.base import (
+    ParseDiagnostic,
+    SymbolReference,
+    normalize_and_sort_references,
+)
 
 _IDENTIFIER_PATTERN = re.compile(r"[A-Za-z_$][A-Za-z0-9_$]*")
 _SYMBOL_BOUNDARY_TEMPLATE = r"(?<![A-Za-z0-9_$]){token}(?![A-Za-z0-9_$])"
@@ -233,6 +237,44 @@ def scan_brace_blocks(
     )
 
 
+def brace_balance_diagnostics(
+    masked_text: str,
+    open_char: str = "{",
+    close_char: str = "}",
+) -> list[ParseDiagnostic]:
+    """Report the first unmatched closing brace and the outermost unclosed opening brace.
+
+    masked_text must have comments and strings masked so braces inside them
+    are not counted. A balanced text yields no diagnostics.
+    """
+    open_lines: list[int] = []
+    first_unmatched: int | None = None
+    line = 1
+    for char in masked_text:
+        if char == open_char:
+            open_lines.append(line)
+        elif char == close_char:
+            if open_lines:
+                open_lines.pop()
+            elif first_unmatched is None:
+                first_unmatched = line
+        elif char == "\n":
+            line += 1
+    diagnostics: list[ParseDiagnostic] = []
+    if first_unmatched is not None:
+        diagnostics.append(
+            ParseDiagnostic(line=first_unmatched, message=f"unmatched '{close_char}'")
+        )
+    if open_lines:
+        diagnostics.append(
+            ParseDiagnostic(
+                line=open_lines[0],
+                message=f"'{open_char}' is never closed ({len(open_lines)} unclosed)",
+            )
+        )
+    return diagnostics
+
+
 def references_for_symbol_lexical(
     symbol: str,
     files: list[tuple[str, str]],
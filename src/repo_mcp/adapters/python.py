@@ -4,16 +4,30 @@ from __future__ import annotations
 
 import ast
 import hashlib
+import re
+from dataclasses import replace
+from pathlib import PurePosixPath
 
 from repo_mcp.adapters.base import (
+    FileImport,
     OutlineSymbol,
+    ParseDiagnostic,
     SymbolReference,
+    assign_package,
+    assign_start_columns,
+    mark_deprecation,
+    normalize_and_sort_imports,
     normalize_and_sort_references,
     normalize_and_sort_symbols,
     normalize_signature,
 )
 
 
+_CONSTANT_NAME_RE = re.compile(r"^_*[A-Z][A-Z0-9_]*$")
+_DEPRECATION_WARNINGS = frozenset({"DeprecationWarning", "PendingDeprecationWarning"})
+_TYPE_FACTORY_CALLS = frozenset({"NewType", "ParamSpec", "TypeVar", "TypeVarTuple"})
+
+
 class PythonAstAdapter:
     """Python-first adapter with AST-based structural outlines."""
 
@@ -29,16 +43,30 @@ class PythonAstAdapter:
         return path.lower().endswith(".py")
 
     def outline(self, path: str, text: str) -> list[OutlineSymbol]:
-        """Extract classes, methods, and functions with line ranges/signatures."""
-        _ = path
-        try:
-            tree = ast.parse(text)
-        except (SyntaxError, ValueError):
+        """Extract classes, methods, functions, and module constants with line ranges.
+
+        The package is the dotted module path derived from the file path. When
+        the module does not parse, the lines before the syntax error are
+        outlined instead, so earlier declarations are still reported.
+        """
+        tree = _parse_best_effort(text)
+        if tree is None:
             return []
 
         collector = _PythonOutlineCollector()
         collector.visit(tree)
-        return normalize_and_sort_symbols(collector.symbols)
+        return normalize_and_sort_symbols(
+            assign_package(
+                assign_start_columns(
+                    [
+                        replace(symbol, visibility=_python_visibility(symbol.name))
+                        for symbol in collector.symbols
+                    ],
+                    text,
+                ),
+                _python_module(path),
+            )
+        )
 
     def smart_chunks(self, path: str, text: str) -> list[tuple[int, int]] | None:
         """Python v1 does not provide smart chunk ranges yet."""
@@ -51,6 +79,64 @@ class PythonAstAdapter:
         _ = prompt
         return ()
 
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """Extract `import` and `from ... import` statements anywhere in the module.
+
+        An import is used when the name it binds appears as a name expression
+        or is listed in a module-level `__all__`; wildcard and `__future__`
+        imports report `used` as `None`. Returns None when the file does not parse.
+        """
+        _ = path
+        try:
+            tree = ast.parse(text)
+        except (SyntaxError, ValueError):
+            return None
+
+        referenced = _referenced_names(tree)
+        records: list[FileImport] = []
+        for node in ast.walk(tree):
+            if isinstance(node, ast.Import):
+                for alias in node.names:
+                    bound = alias.asname or alias.name.split(".", 1)[0]
+                    records.append(
+                        FileImport(
+                            path=alias.name,
+                            name=None,
+                            alias=alias.asname,
+                            line=node.lineno,
+                            used=bound in referenced,
+                        )
+                    )
+            elif isinstance(node, ast.ImportFrom):
+                module = "." * node.level + (node.module or "")
+                for alias in node.names:
+                    if alias.name == "*" or module == "__future__":
+                        used = None
+                    else:
+                        used = (alias.asname or alias.name) in referenced
+                    records.append(
+                        FileImport(
+                            path=module,
+                            name=alias.name,
+                            alias=alias.asname,
+                            line=node.lineno,
+                            used=used,
+                        )
+                    )
+        return normalize_and_sort_imports(records)
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Report the syntax error that stops the module from parsing, if any."""
+        _ = path
+        try:
+            ast.parse(text)
+        except SyntaxError as error:
+            message = error.msg or "invalid syntax"
+            return [ParseDiagnostic(line=error.lineno, message=f"SyntaxError: {message}")]
+        except ValueError as error:
+            return [ParseDiagnostic(line=None, message=f"ValueError: {error}")]
+        return []
+
     def references_for_symbol(
         self,
         symbol: str,
@@ -146,6 +232,21 @@ class PythonAstAdapter:
         return candidates
 
 
+def _parse_best_effort(text: str) -> ast.Module | None:
+    try:
+        return ast.parse(text)
+    except SyntaxError as error:
+        if error.lineno is None or error.lineno <= 1:
+            return None
+        prefix = "\n".join(text.splitlines()[: error.lineno - 1])
+    except ValueError:
+        return None
+    try:
+        return ast.parse(prefix)
+    except (SyntaxError, ValueError):
+        return None
+
+
 def _doc_first_line(
     node: ast.Module | ast.ClassDef | ast.FunctionDef | ast.AsyncFunctionDef,
 ) -> str | None:
@@ -168,20 +269,20 @@ class _PythonOutlineCollector(ast.NodeVisitor):
 
     def visit_ClassDef(self, node: ast.ClassDef) -> None:  # noqa: N802
         parent_symbol = self._parent_symbol()
-        self.symbols.append(
-            OutlineSymbol(
-                kind="class",
-                name=self._qualified_name(node.name),
-                signature=_class_signature(node),
-                start_line=node.lineno,
-                end_line=node.end_lineno or node.lineno,
-                doc=_doc_first_line(node),
-                parent_symbol=parent_symbol,
-                scope_kind=self._scope_kind(),
-                is_conditional=self._is_conditional(),
-                decl_context=self._decl_context(),
-            )
+        symbol = OutlineSymbol(
+            kind="class",
+            name=self._qualified_name(node.name),
+            signature=_class_signature(node),
+            start_line=node.lineno,
+            end_line=node.end_lineno or node.lineno,
+            doc=_doc_first_line(node),
+            parent_symbol=parent_symbol,
+            scope_kind=self._scope_kind(),
+            is_conditional=self._is_conditional(),
+            decl_context=self._decl_context(),
+            decorators=_decorators(node),
         )
+        self.symbols.append(mark_deprecation(symbol, _deprecation_note(node)))
         self._scope_stack.append(("class", node.name))
         self.generic_visit(node)
         self._scope_stack.pop()
@@ -200,24 +301,57 @@ class _PythonOutlineCollector(ast.NodeVisitor):
             kind = "async_function" if isinstance(node, ast.AsyncFunctionDef) else "function"
 
         parent_symbol = self._parent_symbol()
-        self.symbols.append(
-            OutlineSymbol(
-                kind=kind,
-                name=self._qualified_name(node.name),
-                signature=normalize_signature(f"({ast.unparse(node.args)})"),
-                start_line=node.lineno,
-                end_line=node.end_lineno or node.lineno,
-                doc=_doc_first_line(node),
-                parent_symbol=parent_symbol,
-                scope_kind=self._scope_kind(),
-                is_conditional=self._is_conditional(),
-                decl_context=self._decl_context(),
-            )
+        symbol = OutlineSymbol(
+            kind=kind,
+            name=self._qualified_name(node.name),
+            signature=normalize_signature(f"({ast.unparse(node.args)})"),
+            start_line=node.lineno,
+            end_line=node.end_lineno or node.lineno,
+            doc=_doc_first_line(node),
+            parent_symbol=parent_symbol,
+            scope_kind=self._scope_kind(),
+            is_conditional=self._is_conditional(),
+            decl_context=self._decl_context(),
+            decorators=_decorators(node),
+            returns=(ast.unparse(node.returns),) if node.returns is not None else None,
         )
+        self.symbols.append(mark_deprecation(symbol, _deprecation_note(node)))
         self._scope_stack.append(("function", node.name))
         self.generic_visit(node)
         self._scope_stack.pop()
 
+    def visit_Assign(self, node: ast.Assign) -> None:  # noqa: N802
+        for target in node.targets:
+            self._add_constant_symbols(node, target, node.value)
+
+    def visit_AnnAssign(self, node: ast.AnnAssign) -> None:  # noqa: N802
+        self._add_constant_symbols(node, node.target, node.value)
+
+    def _add_constant_symbols(
+        self,
+        node: ast.Assign | ast.AnnAssign,
+        target: ast.expr,
+        value: ast.expr | None,
+    ) -> None:
+        if self._scope_stack or value is None or _is_type_factory_call(value):
+            return
+        for name in _constant_target_names(target):
+            self.symbols.append(
+                OutlineSymbol(
+                    kind="constant",
+                    name=name,
+                    signature=None,
+                    start_line=node.lineno,
+                    end_line=node.end_lineno or node.lineno,
+                    doc=None,
+                    parent_symbol=None,
+                    scope_kind="module",
+                    is_conditional=self._is_conditional(),
+                    decl_context=self._decl_context(),
+                    deprecated=False,
+                )
+            )
+
     def _qualified_name(self, local_name: str) -> str:
         if not self._scope_stack:
             return local_name
@@ -274,6 +408,112 @@ class _PythonOutlineCollector(ast.NodeVisitor):
         self._visit_control_node("match", node)
 
 
+def _referenced_names(tree: ast.Module) -> set[str]:
+    names = {node.id for node in ast.walk(tree) if isinstance(node, ast.Name)}
+    for statement in tree.body:
+        if not isinstance(statement, ast.Assign):
+            continue
+        targets = statement.targets
+        if not any(isinstance(target, ast.Name) and target.id == "__all__" for target in targets):
+            continue
+        if isinstance(statement.value, (ast.List, ast.Tuple)):
+            names.update(
+                element.value
+                for element in statement.value.elts
+                if isinstance(element, ast.Constant) and isinstance(element.value, str)
+            )
+    return names
+
+
+def _python_module(path: str) -> str | None:
+    """Return the dotted module for path, dropping a leading `src/` and `__init__`."""
+    parts = PurePosixPath(path).with_suffix("").parts
+    if parts and parts[0] == "src":
+        parts = parts[1:]
+    if parts and parts[-1] == "__init__":
+        parts = parts[:-1]
+    return ".".join(parts) if parts else None
+
+
+def _python_visibility(name: str) -> str:
+    local_name = name.rsplit(".", 1)[-1]
+    if local_name.startswith("__") and local_name.endswith("__"):
+        return "public"
+    return "private" if local_name.startswith("_") else "public"
+
+
+def _decorators(
+    node: ast.ClassDef | ast.FunctionDef | ast.AsyncFunctionDef,
+) -> tuple[str, ...] | None:
+    if not node.decorator_list:
+        return None
+    return tuple(ast.unparse(decorator) for decorator in node.decorator_list)
+
+
+def _deprecation_note(
+    node: ast.ClassDef | ast.FunctionDef | ast.AsyncFunctionDef,
+) -> str | None:
+    """Return node's deprecation message, or None when it is not deprecated.
+
+    The message is empty when the marker carries no string. Recognizes a
+    `@deprecated(...)` decorator (PEP 702, including `typing_extensions.deprecated`
+    and `warnings.deprecated`) and, for functions, a top-level `warnings.warn(...)`
+    statement whose category is `DeprecationWarning` or `PendingDeprecationWarning`.
+    """
+    for decorator in node.decorator_list:
+        call = decorator if isinstance(decorator, ast.Call) else None
+        target = call.func if call is not None else decorator
+        if _callee_name(target) == "deprecated":
+            return _string_argument(call) if call is not None else ""
+    if isinstance(node, ast.ClassDef):
+        return None
+    for statement in node.body:
+        if not isinstance(statement, ast.Expr) or not isinstance(statement.value, ast.Call):
+            continue
+        call = statement.value
+        if _callee_name(call.func) != "warn":
+            continue
+        category = call.args[1] if len(call.args) > 1 else None
+        for keyword in call.keywords:
+            if keyword.arg == "category":
+                category = keyword.value
+        if category is not None and _callee_name(category) in _DEPRECATION_WARNINGS:
+            return _string_argument(call)
+    return None
+
+
+def _callee_name(node: ast.expr) -> str | None:
+    if isinstance(node, ast.Name):
+        return node.id
+    if isinstance(node, ast.Attribute):
+        return node.attr
+    return None
+
+
+def _string_argument(call: ast.Call) -> str:
+    if call.args and isinstance(call.args[0], ast.Constant) and isinstance(call.args[0].value, str):
+        return " ".join(call.args[0].value.split())
+    return ""
+
+
+def _constant_target_names(target: ast.expr) -> list[str]:
+    if isinstance(target, ast.Name):
+        return [target.id] if _CONSTANT_NAME_RE.match(target.id) else []
+    if isinstance(target, ast.Tuple | ast.List):
+        names: list[str] = []
+        for element in target.elts:
+            names.extend(_constant_target_names(element))
+        return names
+    return []
+
+
+def _is_type_factory_call(value: ast.expr) -> bool:
+    if not isinstance(value, ast.Call):
+        return False
+    dotted = _dotted_name(value.func)
+    return dotted is not None and dotted.rsplit(".", 1)[-1] in _TYPE_FACTORY_CALLS
+
+
 def _class_signature(node: ast.ClassDef) -> str | None:
     parts = [ast.unparse(base) for base in node.bases]
     for keyword in node.keywords:
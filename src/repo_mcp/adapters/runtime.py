@@ -7,6 +7,7 @@ from repo_mcp.adapters.csharp import CSharpLexicalAdapter
 from repo_mcp.adapters.fallback import LexicalFallbackAdapter
 from repo_mcp.adapters.go import GoLexicalAdapter
 from repo_mcp.adapters.java import JavaLexicalAdapter
+from repo_mcp.adapters.kotlin import KotlinLexicalAdapter
 from repo_mcp.adapters.python import PythonAstAdapter
 from repo_mcp.adapters.registry import AdapterRegistry
 from repo_mcp.adapters.rust import RustLexicalAdapter
@@ -23,6 +24,7 @@ def build_adapter_registry(config: ServerConfig) -> AdapterRegistry:
     registry.register(CppLexicalAdapter())
     registry.register(GoLexicalAdapter())
     registry.register(JavaLexicalAdapter())
+    registry.register(KotlinLexicalAdapter())
     registry.register(RustLexicalAdapter())
     registry.register(TypeScriptJavaScriptLexicalAdapter())
     registry.register(LexicalFallbackAdapter(), fallback=True)
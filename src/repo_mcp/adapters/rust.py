@@ -3,28 +3,46 @@
 from __future__ import annotations
 
 import re
-
-from repo_mcp.adapters.base import OutlineSymbol, SymbolReference, normalize_and_sort_symbols
+from dataclasses import replace
+from pathlib import PurePosixPath
+
+from repo_mcp.adapters.base import (
+    FileImport,
+    OutlineSymbol,
+    ParseDiagnostic,
+    SymbolReference,
+    assign_package,
+    assign_start_columns,
+    normalize_and_sort_diagnostics,
+    normalize_and_sort_symbols,
+)
 from repo_mcp.adapters.lexical import (
+    LexicalRules,
+    brace_balance_diagnostics,
     mask_comments_and_strings,
     references_for_symbol_lexical,
     references_for_symbols_lexical,
     scan_brace_blocks,
 )
 
-_MOD_RE = re.compile(r"^\s*(?:pub\s+)?mod\s+([A-Za-z_][A-Za-z0-9_]*)\b")
-_STRUCT_RE = re.compile(r"^\s*(?:pub\s+)?struct\s+([A-Za-z_][A-Za-z0-9_]*)\b")
-_ENUM_RE = re.compile(r"^\s*(?:pub\s+)?enum\s+([A-Za-z_][A-Za-z0-9_]*)\b")
-_TRAIT_RE = re.compile(r"^\s*(?:pub\s+)?trait\s+([A-Za-z_][A-Za-z0-9_]*)\b")
-_CONST_RE = re.compile(r"^\s*(?:pub(?:\([^)]*\))?\s+)?const\s+([A-Za-z_][A-Za-z0-9_]*)\b")
-_TYPE_ALIAS_RE = re.compile(r"^\s*(?:pub\s+)?type\s+([A-Za-z_][A-Za-z0-9_]*)\b")
+_RUST_RULES = LexicalRules(line_comment_prefixes=("//",), string_delimiters=('"',))
+_CHAR_LITERAL_RE = re.compile(r"'(?:\\(?:x[0-9A-Fa-f]{2}|u\{[0-9A-Fa-f]{1,6}\}|.)|[^'\\\n])'")
+_VISIBILITY = r"^\s*(?P<vis>pub(?:\s*\([^)]*\))?\s+)?"
+_MOD_RE = re.compile(_VISIBILITY + r"mod\s+([A-Za-z_][A-Za-z0-9_]*)\b")
+_STRUCT_RE = re.compile(_VISIBILITY + r"struct\s+([A-Za-z_][A-Za-z0-9_]*)\b")
+_ENUM_RE = re.compile(_VISIBILITY + r"enum\s+([A-Za-z_][A-Za-z0-9_]*)\b")
+_TRAIT_RE = re.compile(_VISIBILITY + r"(?:unsafe\s+)?trait\s+([A-Za-z_][A-Za-z0-9_]*)\b")
+_CONST_RE = re.compile(_VISIBILITY + r"const\s+([A-Za-z_][A-Za-z0-9_]*)\b")
+_STATIC_RE = re.compile(_VISIBILITY + r"static\s+(?:mut\s+)?([A-Za-z_][A-Za-z0-9_]*)\b")
+_TYPE_ALIAS_RE = re.compile(_VISIBILITY + r"type\s+([A-Za-z_][A-Za-z0-9_]*)\b")
 _FN_RE = re.compile(
-    r"^\s*(?:pub(?:\([^)]*\))?\s+)?(?:async\s+)?fn\s+([A-Za-z_][A-Za-z0-9_]*)\s*\(([^)]*)\)"
-)
-_IMPL_RE = re.compile(r"^\s*impl(?:<[^>]+>)?\s+(.+?)\s*\{")
-_IMPL_FN_RE = re.compile(
-    r"^\s*(?:pub(?:\([^)]*\))?\s+)?(?:async\s+)?fn\s+([A-Za-z_][A-Za-z0-9_]*)\s*\(([^)]*)\)"
+    _VISIBILITY
+    + r"(?:(?:const|async|unsafe)\s+)*(?:extern\s+\S+\s+)?fn\s+([A-Za-z_][A-Za-z0-9_]*)"
+    r"\s*(?:<[^(]*>)?\s*\(([^)]*)\)"
 )
+_IMPL_RE = re.compile(r"^\s*(?:unsafe\s+)?impl(?:<[^>]+>)?\s+(.+?)\s*\{")
+_VARIANT_RE = re.compile(r"^\s*([A-Za-z_][A-Za-z0-9_]*)\s*(?:[({,=]|$)")
+_GENERIC_ARGS_RE = re.compile(r"<[^<>]*>")
 
 
 class RustLexicalAdapter:
@@ -37,15 +55,21 @@ class RustLexicalAdapter:
         return path.lower().endswith(".rs")
 
     def outline(self, path: str, text: str) -> list[OutlineSymbol]:
-        """Extract top-level Rust items and impl methods deterministically."""
-        _ = path
-        masked = mask_comments_and_strings(text)
+        """Extract top-level Rust items, their members, and impl methods deterministically.
+
+        Enum variants, trait methods, and impl methods are emitted as children of
+        their type. `impl Trait for Type` blocks record the trait in `implements`
+        on the impl block and on the type when it is declared in the same file.
+        The package is the module path under the crate root, such as `crate.engine`.
+        """
+        masked = _mask_rust(text)
         lines = masked.splitlines()
         depth_before = _line_depths(masked)
         block_ends = _block_end_by_start_line(masked)
 
         symbols: list[OutlineSymbol] = []
-        impl_blocks: list[tuple[int, int, str | None]] = []
+        impl_blocks: list[tuple[int, int, str | None, str | None]] = []
+        member_blocks: list[tuple[str, int, int, OutlineSymbol]] = []
 
         for index, line in enumerate(lines):
             line_number = index + 1
@@ -57,11 +81,12 @@ class RustLexicalAdapter:
                 symbols.append(
                     OutlineSymbol(
                         kind="mod",
-                        name=mod_match.group(1),
+                        name=mod_match.group(2),
                         signature=None,
                         start_line=line_number,
                         end_line=max(line_number, block_ends.get(line_number, line_number)),
                         doc=None,
+                        visibility=_rust_visibility(mod_match.group("vis")),
                     )
                 )
                 continue
@@ -71,46 +96,53 @@ class RustLexicalAdapter:
                 symbols.append(
                     OutlineSymbol(
                         kind="struct",
-                        name=struct_match.group(1),
+                        name=struct_match.group(2),
                         signature=None,
                         start_line=line_number,
                         end_line=max(line_number, block_ends.get(line_number, line_number)),
                         doc=None,
+                        visibility=_rust_visibility(struct_match.group("vis")),
                     )
                 )
                 continue
 
             enum_match = _ENUM_RE.match(line)
             if enum_match is not None:
-                symbols.append(
-                    OutlineSymbol(
-                        kind="enum",
-                        name=enum_match.group(1),
-                        signature=None,
-                        start_line=line_number,
-                        end_line=max(line_number, block_ends.get(line_number, line_number)),
-                        doc=None,
-                    )
+                enum_symbol = OutlineSymbol(
+                    kind="enum",
+                    name=enum_match.group(2),
+                    signature=None,
+                    start_line=line_number,
+                    end_line=max(line_number, block_ends.get(line_number, line_number)),
+                    doc=None,
+                    visibility=_rust_visibility(enum_match.group("vis")),
+                )
+                symbols.append(enum_symbol)
+                member_blocks.append(
+                    ("variant", line_number, enum_symbol.end_line, enum_symbol)
                 )
                 continue
 
             trait_match = _TRAIT_RE.match(line)
             if trait_match is not None:
-                symbols.append(
-                    OutlineSymbol(
-                        kind="trait",
-                        name=trait_match.group(1),
-                        signature=None,
-                        start_line=line_number,
-                        end_line=max(line_number, block_ends.get(line_number, line_number)),
-                        doc=None,
-                    )
+                trait_symbol = OutlineSymbol(
+                    kind="trait",
+                    name=trait_match.group(2),
+                    signature=None,
+                    start_line=line_number,
+                    end_line=max(line_number, block_ends.get(line_number, line_number)),
+                    doc=None,
+                    visibility=_rust_visibility(trait_match.group("vis")),
+                )
+                symbols.append(trait_symbol)
+                member_blocks.append(
+                    ("method", line_number, trait_symbol.end_line, trait_symbol)
                 )
                 continue
 
             impl_match = _IMPL_RE.match(line)
             if impl_match is not None:
-                impl_target = _parse_impl_target(impl_match.group(1))
+                impl_trait, impl_target = _parse_impl_head(impl_match.group(1))
                 impl_end = max(line_number, block_ends.get(line_number, line_number))
                 symbols.append(
                     OutlineSymbol(
@@ -120,14 +152,16 @@ class RustLexicalAdapter:
                         start_line=line_number,
                         end_line=impl_end,
                         doc=None,
+                        visibility="public",
+                        implements=(impl_trait,) if impl_trait is not None else None,
                     )
                 )
-                impl_blocks.append((line_number, impl_end, impl_target))
+                impl_blocks.append((line_number, impl_end, impl_target, impl_trait))
                 continue
 
             fn_match = _FN_RE.match(line)
             if fn_match is not None:
-                fn_name, params = fn_match.groups()
+                fn_name, params = fn_match.group(2, 3)
                 symbols.append(
                     OutlineSymbol(
                         kind="function",
@@ -136,6 +170,7 @@ class RustLexicalAdapter:
                         start_line=line_number,
                         end_line=max(line_number, block_ends.get(line_number, line_number)),
                         doc=None,
+                        visibility=_rust_visibility(fn_match.group("vis")),
                     )
                 )
                 continue
@@ -145,11 +180,27 @@ class RustLexicalAdapter:
                 symbols.append(
                     OutlineSymbol(
                         kind="const",
-                        name=const_match.group(1),
+                        name=const_match.group(2),
                         signature=None,
                         start_line=line_number,
                         end_line=line_number,
                         doc=None,
+                        visibility=_rust_visibility(const_match.group("vis")),
+                    )
+                )
+                continue
+
+            static_match = _STATIC_RE.match(line)
+            if static_match is not None:
+                symbols.append(
+                    OutlineSymbol(
+                        kind="static",
+                        name=static_match.group(2),
+                        signature=None,
+                        start_line=line_number,
+                        end_line=line_number,
+                        doc=None,
+                        visibility=_rust_visibility(static_match.group("vis")),
                     )
                 )
                 continue
@@ -159,15 +210,29 @@ class RustLexicalAdapter:
                 symbols.append(
                     OutlineSymbol(
                         kind="type",
-                        name=type_match.group(1),
+                        name=type_match.group(2),
                         signature=None,
                         start_line=line_number,
                         end_line=line_number,
                         doc=None,
+                        visibility=_rust_visibility(type_match.group("vis")),
                     )
                 )
 
-        for impl_start, impl_end, impl_target in impl_blocks:
+        for member_kind, block_start, block_end, owner in member_blocks:
+            symbols.extend(
+                _extract_members(
+                    lines=lines,
+                    depth_before=depth_before,
+                    block_ends=block_ends,
+                    member_kind=member_kind,
+                    block_start=block_start,
+                    block_end=block_end,
+                    owner=owner,
+                )
+            )
+
+        for impl_start, impl_end, impl_target, impl_trait in impl_blocks:
             symbols.extend(
                 _extract_impl_methods(
                     lines=lines,
@@ -176,10 +241,14 @@ class RustLexicalAdapter:
                     impl_start=impl_start,
                     impl_end=impl_end,
                     impl_target=impl_target,
+                    impl_trait=impl_trait,
                 )
             )
 
-        return normalize_and_sort_symbols(symbols)
+        symbols = _attach_implements(symbols, impl_blocks)
+        return normalize_and_sort_symbols(
+            assign_package(assign_start_columns(symbols, text), _rust_module(path))
+        )
 
     def smart_chunks(self, path: str, text: str) -> list[tuple[int, int]] | None:
         """Rust adapter does not provide smart chunk ranges in v1."""
@@ -192,6 +261,18 @@ class RustLexicalAdapter:
         _ = prompt
         return ()
 
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """Rust adapter does not extract imports in v1."""
+        _ = path
+        _ = text
+        return None
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Report unbalanced braces outside comments and strings."""
+        _ = path
+        masked = _mask_rust(text)
+        return normalize_and_sort_diagnostics(brace_balance_diagnostics(masked))
+
     def references_for_symbol(
         self,
         symbol: str,
@@ -223,6 +304,12 @@ class RustLexicalAdapter:
         )
 
 
+def _mask_rust(text: str) -> str:
+    """Mask comments, strings, and char literals without treating lifetimes as quotes."""
+    without_chars = _CHAR_LITERAL_RE.sub(lambda match: " " * len(match.group(0)), text)
+    return mask_comments_and_strings(without_chars, _RUST_RULES)
+
+
 def _line_depths(masked_text: str) -> list[int]:
     depths: list[int] = []
     depth = 0
@@ -245,18 +332,94 @@ def _block_end_by_start_line(masked_text: str) -> dict[int, int]:
     return mapping
 
 
-def _parse_impl_target(impl_head: str) -> str | None:
+def _rust_module(path: str) -> str:
+    """Return the module path for a file relative to its crate's last `src/` directory.
+
+    `lib.rs` and `main.rs` are the crate root and `mod.rs` names its directory.
+    Files outside any `src/` directory (such as integration tests) are treated
+    as crate roots of their own.
+    """
+    parts = list(PurePosixPath(path).with_suffix("").parts)
+    if "src" not in parts:
+        return "crate"
+    parts = parts[len(parts) - parts[::-1].index("src") :]
+    if parts and parts[-1] == "mod":
+        parts.pop()
+    elif len(parts) == 1 and parts[0] in {"lib", "main"}:
+        parts.pop()
+    return ".".join(["crate", *parts])
+
+
+def _rust_visibility(modifier: str | None) -> str:
+    if modifier is not None and modifier.strip() == "pub":
+        return "public"
+    return "private"
+
+
+def _parse_impl_head(impl_head: str) -> tuple[str | None, str | None]:
     part = impl_head.split(" where ")[0].strip()
+    trait: str | None = None
     if " for " in part:
-        part = part.split(" for ", 1)[1].strip()
-    cleaned = re.sub(r"<[^>]*>", "", part).strip()
-    cleaned = cleaned.lstrip("&").lstrip("mut").strip()
-    if not cleaned:
-        return None
+        trait_part, part = (item.strip() for item in part.split(" for ", 1))
+        trait = _strip_generic_args(trait_part).lstrip("!").strip() or None
+    cleaned = re.sub(r"^&\s*(?:'[A-Za-z_][A-Za-z0-9_]*\s+)?(?:mut\s+)?", "", part)
+    cleaned = _strip_generic_args(cleaned)
     match = re.search(r"([A-Za-z_][A-Za-z0-9_]*)$", cleaned)
     if match is None:
-        return None
-    return match.group(1)
+        return trait, None
+    return trait, match.group(1)
+
+
+def _strip_generic_args(text: str) -> str:
+    previous = None
+    while previous != text:
+        previous = text
+        text = _GENERIC_ARGS_RE.sub("", text)
+    return text.strip()
+
+
+def _extract_members(
+    lines: list[str],
+    depth_before: list[int],
+    block_ends: dict[int, int],
+    member_kind: str,
+    block_start: int,
+    block_end: int,
+    owner: OutlineSymbol,
+) -> list[OutlineSymbol]:
+    symbols: list[OutlineSymbol] = []
+    if block_end == block_start:
+        return symbols
+    for line_number in range(block_start + 1, min(block_end, len(lines)) + 1):
+        if depth_before[line_number - 1] != 1:
+            continue
+        line = lines[line_number - 1]
+        if member_kind == "method":
+            matched = _FN_RE.match(line)
+            if matched is None:
+                continue
+            name, signature = matched.group(2), f"({matched.group(3).strip()})"
+        else:
+            if line.lstrip().startswith("#"):
+                continue
+            matched = _VARIANT_RE.match(line)
+            if matched is None:
+                continue
+            name, signature = matched.group(1), None
+        symbols.append(
+            OutlineSymbol(
+                kind=member_kind,
+                name=f"{owner.name}.{name}",
+                signature=signature,
+                start_line=line_number,
+                end_line=max(line_number, block_ends.get(line_number, line_number)),
+                doc=None,
+                parent_symbol=owner.name,
+                scope_kind="class",
+                visibility=owner.visibility,
+            )
+        )
+    return symbols
 
 
 def _extract_impl_methods(
@@ -266,6 +429,7 @@ def _extract_impl_methods(
     impl_start: int,
     impl_end: int,
     impl_target: str | None,
+    impl_trait: str | None,
 ) -> list[OutlineSymbol]:
     symbols: list[OutlineSymbol] = []
     start = max(impl_start + 1, 1)
@@ -274,11 +438,12 @@ def _extract_impl_methods(
         if depth_before[line_number - 1] != 1:
             continue
         line = lines[line_number - 1]
-        matched = _IMPL_FN_RE.match(line)
+        matched = _FN_RE.match(line)
         if matched is None:
             continue
-        method_name, params = matched.groups()
+        method_name, params = matched.group(2, 3)
         prefix = f"{impl_target}." if impl_target else "impl."
+        visibility = "public" if impl_trait else _rust_visibility(matched.group("vis"))
         symbols.append(
             OutlineSymbol(
                 kind="method",
@@ -287,6 +452,26 @@ def _extract_impl_methods(
                 start_line=line_number,
                 end_line=max(line_number, block_ends.get(line_number, line_number)),
                 doc=None,
+                visibility=visibility,
             )
         )
     return symbols
+
+
+def _attach_implements(
+    symbols: list[OutlineSymbol],
+    impl_blocks: list[tuple[int, int, str | None, str | None]],
+) -> list[OutlineSymbol]:
+    traits_by_type: dict[str, set[str]] = {}
+    for _, _, impl_target, impl_trait in impl_blocks:
+        if impl_target is not None and impl_trait is not None:
+            traits_by_type.setdefault(impl_target, set()).add(impl_trait)
+    if not traits_by_type:
+        return symbols
+    output: list[OutlineSymbol] = []
+    for symbol in symbols:
+        traits = traits_by_type.get(symbol.name)
+        if traits and symbol.kind in {"struct", "enum", "type", "trait"}:
+            symbol = replace(symbol, implements=tuple(sorted(traits)))
+        output.append(symbol)
+    return output
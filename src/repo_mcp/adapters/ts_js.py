@@ -4,27 +4,73 @@ from __future__ import annotations
 
 import re
 from dataclasses import dataclass
-
-from repo_mcp.adapters.base import OutlineSymbol, SymbolReference, normalize_and_sort_symbols
+from pathlib import PurePosixPath
+
+from repo_mcp.adapters.base import (
+    FileImport,
+    OutlineSymbol,
+    ParseDiagnostic,
+    SymbolReference,
+    assign_package,
+    assign_start_columns,
+    mark_deprecation,
+    normalize_and_sort_diagnostics,
+    normalize_and_sort_imports,
+    normalize_and_sort_symbols,
+)
 from repo_mcp.adapters.lexical import (
+    LexicalRules,
+    brace_balance_diagnostics,
     mask_comments_and_strings,
     references_for_symbol_lexical,
     references_for_symbols_lexical,
 )
 
-_CLASS_RE = re.compile(r"^\s*(?:export\s+)?(?:default\s+)?class\s+([A-Za-z_$][A-Za-z0-9_$]*)\b")
-_INTERFACE_RE = re.compile(r"^\s*(?:export\s+)?interface\s+([A-Za-z_$][A-Za-z0-9_$]*)\b")
-_ENUM_RE = re.compile(r"^\s*(?:export\s+)?enum\s+([A-Za-z_$][A-Za-z0-9_$]*)\b")
-_TYPE_ALIAS_RE = re.compile(r"^\s*(?:export\s+)?type\s+([A-Za-z_$][A-Za-z0-9_$]*)\b")
+_TS_JS_RULES = LexicalRules(line_comment_prefixes=("//",))
+_IDENT = r"[A-Za-z_$][A-Za-z0-9_$]*"
+_JSDOC_DEPRECATED_RE = re.compile(r"@deprecated\b")
+_EXPORT_PREFIX = r"^\s*(export\s+(?:default\s+)?)?(?:declare\s+)?"
+_CLASS_RE = re.compile(
+    _EXPORT_PREFIX + rf"(?:abstract\s+)?class\s+(?!extends\b|implements\b)({_IDENT})\b"
+)
+_INTERFACE_RE = re.compile(_EXPORT_PREFIX + rf"interface\s+({_IDENT})\b")
+_ENUM_RE = re.compile(_EXPORT_PREFIX + rf"(?:const\s+)?enum\s+({_IDENT})\b")
+_TYPE_ALIAS_RE = re.compile(_EXPORT_PREFIX + rf"type\s+({_IDENT})\b")
 _FUNCTION_RE = re.compile(
-    r"^\s*(?:export\s+)?(?:(async)\s+)?function\s+([A-Za-z_$][A-Za-z0-9_$]*)\s*\(([^)]*)\)"
+    _EXPORT_PREFIX
+    + rf"(?:(async)\s+)?function\b\s*\*?\s*({_IDENT})\s*(?:<[^>(]*>)?\s*\(([^)]*)\)"
+)
+_VARIABLE_RE = re.compile(_EXPORT_PREFIX + rf"(?:const|let|var)\s+({_IDENT})\b")
+_DEFAULT_ANONYMOUS_RE = re.compile(
+    r"^\s*export\s+default\s+(?:(async)\s+)?(?:abstract\s+)?(class|function)\b\s*\*?\s*"
+    r"(?:\(([^)]*)\))?"
+)
+_DEFAULT_IDENTIFIER_RE = re.compile(rf"^\s*export\s+default\s+({_IDENT})\s*;?\s*$")
+_DEFAULT_EXPRESSION_RE = re.compile(r"^\s*export\s+default\s+\S")
+_EXPORT_LIST_RE = re.compile(r"(?m)^\s*export\s+(?:type\s+)?\{([^}]*)\}\s*(from\b)?")
+_COMMONJS_EXPORT_RE = re.compile(
+    rf"^\s*(?:module\.)?exports\.({_IDENT})\s*=\s*(?:({_IDENT})\s*;?\s*$)?"
+)
+_COMMONJS_MODULE_EXPORT_RE = re.compile(rf"^\s*module\.exports\s*=\s*({_IDENT})\s*;?\s*$")
+_MEMBER_MODIFIERS = (
+    r"((?:(?:public|private|protected|static|readonly|override|abstract|declare|get|set|async)"
+    r"\s+)*)"
 )
-_EXPORT_BINDING_RE = re.compile(r"^\s*export\s+(?:const|let|var)\s+([A-Za-z_$][A-Za-z0-9_$]*)\b")
-_COMMONJS_EXPORT_RE = re.compile(r"^\s*(?:module\.)?exports\.([A-Za-z_$][A-Za-z0-9_$]*)\s*=")
 _METHOD_RE = re.compile(
-    r"^\s*(?:public|private|protected|static|readonly|override|abstract|get|set|async|\s)*"
-    r"([A-Za-z_$][A-Za-z0-9_$]*)\s*\(([^)]*)\)\s*\{?"
+    rf"^\s*{_MEMBER_MODIFIERS}\*?\s*(#?{_IDENT})\s*[?!]?\s*(?:<[^>(]*>)?\s*\(([^)]*)\)\s*\{{?"
+)
+_PROPERTY_RE = re.compile(rf"^\s*{_MEMBER_MODIFIERS}(#?{_IDENT})\s*[?!]?\s*(?::([^=;]*))?(?:=|;|$)")
+_IMPORT_RE = re.compile(
+    r"(?m)^[ \t]*(?P<keyword>import)\s+(?:type\s+)?"
+    r"(?:(?P<clause>[^;'\"`]*?)\s*\bfrom\s*)?['\"](?P<specifier>[^'\"]+)['\"]"
+)
+_REQUIRE_RE = re.compile(
+    rf"(?m)^[ \t]*(?:const|let|var)\s+(?P<clause>\{{[^}}]*\}}|{_IDENT})\s*=\s*"
+    r"(?P<keyword>require)\s*\(\s*['\"](?P<specifier>[^'\"]+)['\"]\s*\)"
 )
+_IMPORT_SPECIFIER_RE = re.compile(rf"^(?:type\s+)?({_IDENT}|\*)(?:\s*(?:as|:)\s*({_IDENT}))?$")
+_IDENTIFIER_USE_RE = re.compile(rf"(?<![A-Za-z0-9_$.#]){_IDENT}")
+_PRIVATE_MODIFIERS = frozenset({"private", "protected"})
 _SKIP_METHOD_NAMES = {"if", "for", "while", "switch", "catch", "function"}
 _BLOCK_SYMBOL_KINDS = {"class", "interface", "enum", "function", "async_function"}
 
@@ -48,16 +94,39 @@ class TypeScriptJavaScriptLexicalAdapter:
         return lowered.endswith(self._extensions)
 
     def outline(self, path: str, text: str) -> list[OutlineSymbol]:
-        """Extract deterministic top-level and class-method symbols."""
-        _ = path
-        masked = mask_comments_and_strings(text)
+        """Extract deterministic top-level declarations and class members.
+
+        Top-level symbols are public when exported (``export``, ``export default``,
+        ``export { ... }`` lists, or CommonJS ``exports`` assignments) and private
+        otherwise. Class members are private when marked ``private``/``protected``
+        or named with a ``#`` prefix. The package is the module path without its
+        extension, with a trailing ``/index`` dropped. A ``@deprecated`` tag in
+        the JSDoc block directly above a declaration marks it deprecated.
+        """
+        masked = mask_comments_and_strings(text, _TS_JS_RULES)
         lines = masked.splitlines()
         depth_before = _line_depths(masked)
         blocks = _line_blocks(masked)
+        exported_names = _exported_names(masked, lines, depth_before)
 
         symbols: list[OutlineSymbol] = []
         class_blocks: list[tuple[str, _Block]] = []
 
+        def add_block_symbol(
+            kind: str, name: str, signature: str | None, line_number: int, exported: bool
+        ) -> OutlineSymbol:
+            symbol = OutlineSymbol(
+                kind=kind,
+                name=name,
+                signature=signature,
+                start_line=line_number,
+                end_line=_find_block_end(line_number, blocks),
+                doc=None,
+                visibility=_top_level_visibility(name, exported, exported_names),
+            )
+            symbols.append(symbol)
+            return symbol
+
         for index, line in enumerate(lines):
             line_number = index + 1
             if depth_before[index] != 0:
@@ -65,16 +134,8 @@ class TypeScriptJavaScriptLexicalAdapter:
 
             class_match = _CLASS_RE.match(line)
             if class_match is not None:
-                name = class_match.group(1)
-                symbol = OutlineSymbol(
-                    kind="class",
-                    name=name,
-                    signature="()",
-                    start_line=line_number,
-                    end_line=_find_block_end(line_number, blocks),
-                    doc=None,
-                )
-                symbols.append(symbol)
+                exported, name = class_match.groups()
+                symbol = add_block_symbol("class", name, "()", line_number, exported is not None)
                 class_blocks.append(
                     (
                         name,
@@ -89,76 +150,86 @@ class TypeScriptJavaScriptLexicalAdapter:
 
             interface_match = _INTERFACE_RE.match(line)
             if interface_match is not None:
-                name = interface_match.group(1)
-                symbols.append(
-                    OutlineSymbol(
-                        kind="interface",
-                        name=name,
-                        signature="()",
-                        start_line=line_number,
-                        end_line=_find_block_end(line_number, blocks),
-                        doc=None,
-                    )
-                )
+                exported, name = interface_match.groups()
+                add_block_symbol("interface", name, "()", line_number, exported is not None)
                 continue
 
             enum_match = _ENUM_RE.match(line)
             if enum_match is not None:
-                name = enum_match.group(1)
-                symbols.append(
-                    OutlineSymbol(
-                        kind="enum",
-                        name=name,
-                        signature="()",
-                        start_line=line_number,
-                        end_line=_find_block_end(line_number, blocks),
-                        doc=None,
-                    )
-                )
+                exported, name = enum_match.groups()
+                add_block_symbol("enum", name, "()", line_number, exported is not None)
                 continue
 
             type_alias_match = _TYPE_ALIAS_RE.match(line)
             if type_alias_match is not None:
+                exported, name = type_alias_match.groups()
                 symbols.append(
                     OutlineSymbol(
                         kind="type_alias",
-                        name=type_alias_match.group(1),
+                        name=name,
                         signature=None,
                         start_line=line_number,
                         end_line=line_number,
                         doc=None,
+                        visibility=_top_level_visibility(
+                            name, exported is not None, exported_names
+                        ),
                     )
                 )
                 continue
 
             function_match = _FUNCTION_RE.match(line)
             if function_match is not None:
-                is_async = function_match.group(1) is not None
-                signature = f"({function_match.group(3).strip()})"
-                kind = "async_function" if is_async else "function"
-                end_line = _find_block_end(line_number, blocks)
+                exported, is_async, name, params = function_match.groups()
+                kind = "async_function" if is_async is not None else "function"
+                signature = f"({params.strip()})"
+                add_block_symbol(kind, name, signature, line_number, exported is not None)
+                continue
+
+            anonymous_match = _DEFAULT_ANONYMOUS_RE.match(line)
+            if anonymous_match is not None:
+                is_async, declaration, params = anonymous_match.groups()
+                if declaration == "class":
+                    symbol = add_block_symbol("class", "default", "()", line_number, True)
+                    class_blocks.append(
+                        (
+                            "default",
+                            _Block(start_line=symbol.start_line, end_line=symbol.end_line, depth=1),
+                        )
+                    )
+                    continue
+                kind = "async_function" if is_async is not None else "function"
+                signature = f"({(params or '').strip()})"
+                add_block_symbol(kind, "default", signature, line_number, True)
+                continue
+
+            variable_match = _VARIABLE_RE.match(line)
+            if variable_match is not None:
+                exported, name = variable_match.groups()
+                is_exported = exported is not None or name in exported_names
                 symbols.append(
                     OutlineSymbol(
-                        kind=kind,
-                        name=function_match.group(2),
-                        signature=signature,
+                        kind="exported_variable" if is_exported else "variable",
+                        name=name,
+                        signature=None,
                         start_line=line_number,
-                        end_line=end_line,
+                        end_line=line_number,
                         doc=None,
+                        visibility="public" if is_exported else "private",
                     )
                 )
                 continue
 
-            export_match = _EXPORT_BINDING_RE.match(line)
-            if export_match is not None:
+            if _DEFAULT_IDENTIFIER_RE.match(line) is None and _DEFAULT_EXPRESSION_RE.match(line):
                 symbols.append(
                     OutlineSymbol(
                         kind="exported_variable",
-                        name=export_match.group(1),
+                        name="default",
                         signature=None,
                         start_line=line_number,
                         end_line=line_number,
                         doc=None,
+                        visibility="public",
                     )
                 )
                 continue
@@ -173,12 +244,13 @@ class TypeScriptJavaScriptLexicalAdapter:
                         start_line=line_number,
                         end_line=line_number,
                         doc=None,
+                        visibility="public",
                     )
                 )
 
         for class_name, class_block in class_blocks:
             symbols.extend(
-                _extract_class_methods(
+                _extract_class_members(
                     class_name=class_name,
                     lines=lines,
                     depth_before=depth_before,
@@ -187,8 +259,15 @@ class TypeScriptJavaScriptLexicalAdapter:
                 )
             )
 
-        filtered = [symbol for symbol in symbols if symbol.kind or symbol.name]
-        return normalize_and_sort_symbols(filtered)
+        raw_lines = text.splitlines()
+        filtered = [
+            mark_deprecation(symbol, _jsdoc_deprecation_note(raw_lines, symbol.start_line))
+            for symbol in symbols
+            if symbol.kind or symbol.name
+        ]
+        return normalize_and_sort_symbols(
+            assign_package(assign_start_columns(filtered, text), _module_path(path))
+        )
 
     def smart_chunks(self, path: str, text: str) -> list[tuple[int, int]] | None:
         """TS/JS adapter does not provide smart chunk ranges in v1."""
@@ -201,6 +280,67 @@ class TypeScriptJavaScriptLexicalAdapter:
         _ = prompt
         return ()
 
+    def imports(self, path: str, text: str) -> list[FileImport] | None:
+        """Extract ES module imports and CommonJS `require` bindings.
+
+        Default imports are named `default` and namespace imports `*`, with the
+        local binding as alias; `const x = require("m")` binds the whole module
+        to alias `x`. A binding is used when its identifier appears outside
+        import statements, comments, and strings. Side-effect imports report
+        `used` as `None`. Re-exports (`export ... from`) are not imports.
+        """
+        _ = path
+        masked = mask_comments_and_strings(text, _TS_JS_RULES)
+        statements: list[tuple[int, int, int, str, list[tuple[str | None, str | None]]]] = []
+        for pattern, parse_clause in (
+            (_IMPORT_RE, _es_import_bindings),
+            (_REQUIRE_RE, _require_bindings),
+        ):
+            for match in pattern.finditer(text):
+                keyword_start, keyword_end = match.span("keyword")
+                if masked[keyword_start:keyword_end] != match.group("keyword"):
+                    continue
+                line = text.count("\n", 0, match.start()) + 1
+                statements.append(
+                    (
+                        match.start(),
+                        match.end(),
+                        line,
+                        match.group("specifier"),
+                        parse_clause(match.group("clause")),
+                    )
+                )
+
+        remaining = list(masked)
+        for start, end, _, _, _ in statements:
+            remaining[start:end] = " " * (end - start)
+        identifiers = set(_IDENTIFIER_USE_RE.findall("".join(remaining)))
+
+        records: list[FileImport] = []
+        for _, _, line, specifier, bindings in statements:
+            if not bindings:
+                records.append(
+                    FileImport(path=specifier, name=None, alias=None, line=line, used=None)
+                )
+            for name, alias in bindings:
+                bound = alias or name or ""
+                records.append(
+                    FileImport(
+                        path=specifier,
+                        name=name,
+                        alias=alias,
+                        line=line,
+                        used=bound in identifiers,
+                    )
+                )
+        return normalize_and_sort_imports(records)
+
+    def diagnostics(self, path: str, text: str) -> list[ParseDiagnostic] | None:
+        """Report unbalanced braces outside comments and strings."""
+        _ = path
+        masked = mask_comments_and_strings(text, _TS_JS_RULES)
+        return normalize_and_sort_diagnostics(brace_balance_diagnostics(masked))
+
     def references_for_symbol(
         self,
         symbol: str,
@@ -232,6 +372,47 @@ class TypeScriptJavaScriptLexicalAdapter:
         )
 
 
+def _jsdoc_deprecation_note(raw_lines: list[str], start_line: int) -> str | None:
+    """Return the `@deprecated` text of the JSDoc block above start_line, if tagged.
+
+    Decorator lines between the block and the declaration are skipped. The note
+    runs to the next tag or blank line, with lines joined by single spaces.
+    """
+    cursor = start_line - 2
+    while cursor >= 0 and raw_lines[cursor].strip().startswith("@"):
+        cursor -= 1
+    if cursor < 0 or not raw_lines[cursor].rstrip().endswith("*/"):
+        return None
+    block_end = cursor
+    while cursor >= 0 and "/*" not in raw_lines[cursor]:
+        cursor -= 1
+    if cursor < 0 or "/**" not in raw_lines[cursor]:
+        return None
+    note: list[str] | None = None
+    for raw in raw_lines[cursor : block_end + 1]:
+        line = raw.strip().removeprefix("/**").removesuffix("*/").strip()
+        line = line.removeprefix("*").strip()
+        if note is None:
+            marker = _JSDOC_DEPRECATED_RE.match(line)
+            if marker is not None:
+                note = [line[marker.end() :].strip()]
+            continue
+        if not line or line.startswith("@"):
+            break
+        note.append(line)
+    if note is None:
+        return None
+    return " ".join(" ".join(note).split())
+
+
+def _module_path(path: str) -> str:
+    """Return path without its extension, dropping a trailing `/index` module."""
+    module = PurePosixPath(path).with_suffix("")
+    if module.name == "index" and module.parent.parts:
+        module = module.parent
+    return module.as_posix()
+
+
 def _line_depths(masked_text: str) -> list[int]:
     depths: list[int] = []
     depth = 0
@@ -274,7 +455,85 @@ def _find_block_end(start_line: int, blocks: list[_Block]) -> int:
     return start_line
 
 
-def _extract_class_methods(
+def _es_import_bindings(clause: str | None) -> list[tuple[str | None, str | None]]:
+    """Return `(imported name, local alias)` pairs for an ES import clause."""
+    if not clause:
+        return []
+    bindings: list[tuple[str | None, str | None]] = []
+    named = ""
+    head = clause
+    if "{" in clause:
+        head, _, rest = clause.partition("{")
+        named = rest.partition("}")[0]
+    for part in [item.strip() for item in head.split(",")]:
+        if part.startswith("*"):
+            namespace = _IMPORT_SPECIFIER_RE.match(part)
+            if namespace is not None and namespace.group(2):
+                bindings.append(("*", namespace.group(2)))
+        elif part:
+            bindings.append(("default", part))
+    bindings.extend(_named_bindings(named))
+    return bindings
+
+
+def _require_bindings(target: str | None) -> list[tuple[str | None, str | None]]:
+    """Return `(imported name, local alias)` pairs for a `require` assignment target."""
+    if not target:
+        return []
+    if target.startswith("{"):
+        return _named_bindings(target.strip("{} "))
+    return [(None, target)]
+
+
+def _named_bindings(named: str) -> list[tuple[str | None, str | None]]:
+    bindings: list[tuple[str | None, str | None]] = []
+    for part in named.split(","):
+        specifier = _IMPORT_SPECIFIER_RE.match(part.strip())
+        if specifier is not None:
+            bindings.append((specifier.group(1), specifier.group(2)))
+    return bindings
+
+
+def _exported_names(masked_text: str, lines: list[str], depth_before: list[int]) -> set[str]:
+    """Collect local names exported by reference rather than by declaration keyword."""
+    names: set[str] = set()
+    for match in _EXPORT_LIST_RE.finditer(masked_text):
+        if match.group(2) is not None:
+            continue
+        for specifier in match.group(1).split(","):
+            local = specifier.strip().removeprefix("type ").split(" as ", 1)[0].strip()
+            if local:
+                names.add(local)
+    for index, line in enumerate(lines):
+        if depth_before[index] != 0:
+            continue
+        default_match = _DEFAULT_IDENTIFIER_RE.match(line)
+        if default_match is not None:
+            names.add(default_match.group(1))
+            continue
+        commonjs_match = _COMMONJS_EXPORT_RE.match(line)
+        if commonjs_match is not None and commonjs_match.group(2) is not None:
+            names.add(commonjs_match.group(2))
+            continue
+        module_match = _COMMONJS_MODULE_EXPORT_RE.match(line)
+        if module_match is not None:
+            names.add(module_match.group(1))
+    return names
+
+
+def _top_level_visibility(name: str, exported: bool, exported_names: set[str]) -> str:
+    if exported or name in exported_names:
+        return "public"
+    return "private"
+
+
+def _member_visibility(modifiers: str, name: str) -> str:
+    if name.startswith("#") or _PRIVATE_MODIFIERS.intersection(modifiers.split()):
+        return "private"
+    return "public"
+
+
+def _extract_class_members(
     class_name: str,
     lines: list[str],
     depth_before: list[int],
@@ -290,12 +549,28 @@ def _extract_class_methods(
             continue
         match = _METHOD_RE.match(line)
         if match is None:
+            property_match = _PROPERTY_RE.match(line)
+            if property_match is None:
+                continue
+            modifiers, property_name, annotation = property_match.groups()
+            symbols.append(
+                OutlineSymbol(
+                    kind="property",
+                    name=f"{class_name}.{property_name}",
+                    signature=annotation.strip() if annotation else None,
+                    start_line=line_number,
+                    end_line=line_number,
+                    doc=None,
+                    scope_kind="class",
+                    visibility=_member_visibility(modifiers, property_name),
+                )
+            )
             continue
-        method_name = match.group(1)
+        modifiers, method_name, params = match.groups()
         if method_name in _SKIP_METHOD_NAMES:
             continue
-        signature = f"({match.group(2).strip()})"
-        kind = "async_method" if "async " in line else "method"
+        signature = f"({params.strip()})"
+        kind = "async_method" if "async" in modifiers.split() else "method"
         end_line = _find_block_end(
             line_number,
             [block for block in blocks if block.depth >= class_block.depth + 1],
@@ -309,6 +584,7 @@ def _extract_class_methods(
                     start_line=line_number,
                     end_line=max(line_number, end_line),
                     doc=None,
+                    visibility=_member_visibility(modifiers, method_name),
                 )
             )
     return symbols
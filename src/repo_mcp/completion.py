@@ -0,0 +1,359 @@
+"""Shell completion scripts and a flag listing derived from the CLI parser.
+
+Everything here is generated from the `argparse` definition, so a flag added to
+the parser is completed and listed without further changes.
+"""
+
+from __future__ import annotations
+
+import argparse
+import json
+from dataclasses import dataclass
+
+COMPLETION_SHELLS = ("bash", "fish", "zsh")
+_PATH_METAVAR = "PATH"
+
+
+@dataclass(slots=True, frozen=True)
+class FlagSpec:
+    """One option or positional argument of a command.
+
+    `type` is `boolean` for flags that take no value, otherwise `choice`,
+    `integer`, `path`, or `string`. `default` is None when the parser leaves
+    the value unset, which for most startup flags means "use the config".
+    """
+
+    name: str
+    aliases: tuple[str, ...]
+    type: str
+    metavar: str | None
+    choices: tuple[str, ...] | None
+    default: object
+    repeatable: bool
+    help: str
+
+
+@dataclass(slots=True, frozen=True)
+class CommandSpec:
+    """A command with its flags, positional arguments, and subcommands, all sorted by name."""
+
+    name: str
+    help: str
+    flags: tuple[FlagSpec, ...]
+    arguments: tuple[FlagSpec, ...]
+    commands: tuple[CommandSpec, ...]
+
+
+def describe_parser(parser: argparse.ArgumentParser, *, help_text: str = "") -> CommandSpec:
+    """Return the command tree of parser, recursing into its subcommands."""
+    flags: list[FlagSpec] = []
+    arguments: list[FlagSpec] = []
+    commands: list[CommandSpec] = []
+    for action in parser._actions:
+        if isinstance(action, argparse._SubParsersAction):
+            helps = {choice.dest: choice.help or "" for choice in action._choices_actions}
+            for command_name, subparser in action.choices.items():
+                commands.append(describe_parser(subparser, help_text=helps.get(command_name, "")))
+        elif action.option_strings:
+            flags.append(_flag_spec(action))
+        else:
+            arguments.append(_flag_spec(action))
+    return CommandSpec(
+        name=parser.prog,
+        help=help_text or parser.description or "",
+        flags=tuple(sorted(flags, key=lambda flag: flag.name)),
+        arguments=tuple(arguments),
+        commands=tuple(sorted(commands, key=lambda command: command.name)),
+    )
+
+
+def render_flags(parser: argparse.ArgumentParser, *, as_json: bool) -> str:
+    """Return every command and flag of parser as JSON or as an aligned text listing."""
+    spec = describe_parser(parser)
+    if as_json:
+        return f"{json.dumps(_command_payload(spec), indent=2, sort_keys=True)}\n"
+    lines: list[str] = []
+    for command in _walk(spec):
+        if lines:
+            lines.append("")
+        lines.append(command.name)
+        for flag in (*command.arguments, *command.flags):
+            usage = " ".join((*flag.aliases, flag.name))
+            if flag.type != "boolean":
+                usage = f"{usage} {_value_label(flag)}"
+            lines.append(f"  {usage:<40} {flag.help}".rstrip())
+        for child in command.commands:
+            lines.append(f"  {child.name.rsplit(' ', 1)[-1]:<40} {child.help}".rstrip())
+    return "\n".join(lines) + "\n"
+
+
+def render_completion(parser: argparse.ArgumentParser, shell: str) -> str:
+    """Return a completion script for shell (`bash`, `fish`, or `zsh`).
+
+    Root flags are completed before a subcommand, each subcommand's flags and
+    positional choices after it. Flags with a `PATH` value complete file
+    names; flags with choices complete those choices.
+    """
+    spec = describe_parser(parser)
+    if shell == "bash":
+        return _bash_script(spec)
+    if shell == "fish":
+        return _fish_script(spec)
+    if shell == "zsh":
+        return _zsh_script(spec)
+    allowed = ", ".join(COMPLETION_SHELLS)
+    raise ValueError(f"Unsupported completion shell '{shell}'; expected one of: {allowed}.")
+
+
+def _flag_spec(action: argparse.Action) -> FlagSpec:
+    long_options = [option for option in action.option_strings if option.startswith("--")]
+    name = (long_options or list(action.option_strings) or [action.dest])[0]
+    if action.nargs == 0:
+        value_type = "boolean"
+    elif action.choices is not None:
+        value_type = "choice"
+    elif action.type is int:
+        value_type = "integer"
+    elif action.metavar == _PATH_METAVAR:
+        value_type = "path"
+    else:
+        value_type = "string"
+    default = None if action.default is argparse.SUPPRESS else action.default
+    return FlagSpec(
+        name=name,
+        aliases=tuple(option for option in action.option_strings if option != name),
+        type=value_type,
+        metavar=action.metavar if isinstance(action.metavar, str) else None,
+        choices=tuple(str(choice) for choice in action.choices) if action.choices else None,
+        default=default,
+        repeatable=isinstance(action, argparse._AppendAction),
+        help=action.help or "",
+    )
+
+
+def _command_payload(command: CommandSpec) -> dict[str, object]:
+    return {
+        "name": command.name,
+        "help": command.help,
+        "flags": [_flag_payload(flag) for flag in command.flags],
+        "arguments": [_flag_payload(flag) for flag in command.arguments],
+        "commands": [_command_payload(child) for child in command.commands],
+    }
+
+
+def _flag_payload(flag: FlagSpec) -> dict[str, object]:
+    return {
+        "name": flag.name,
+        "aliases": list(flag.aliases),
+        "type": flag.type,
+        "metavar": flag.metavar,
+        "choices": list(flag.choices) if flag.choices is not None else None,
+        "default": flag.default,
+        "repeatable": flag.repeatable,
+        "help": flag.help,
+    }
+
+
+def _walk(command: CommandSpec) -> list[CommandSpec]:
+    walked = [command]
+    for child in command.commands:
+        walked.extend(_walk(child))
+    return walked
+
+
+def _value_label(flag: FlagSpec) -> str:
+    if flag.choices is not None:
+        return "{" + ",".join(flag.choices) + "}"
+    return flag.metavar or "VALUE"
+
+
+def _options(flag: FlagSpec) -> tuple[str, ...]:
+    return (*flag.aliases, flag.name)
+
+
+def _subcommand_name(command: CommandSpec) -> str:
+    return command.name.rsplit(" ", 1)[-1]
+
+
+def _bash_script(spec: CommandSpec) -> str:
+    function = f"_{_identifier(spec.name)}"
+    names = " ".join(_subcommand_name(child) for child in spec.commands)
+    value_cases: list[str] = []
+    word_cases: list[str] = []
+    for command in (spec, *spec.commands):
+        key = "" if command is spec else _subcommand_name(command)
+        for flag in command.flags:
+            if flag.type == "boolean":
+                continue
+            patterns = "|".join(f"{key}:{option}" for option in _options(flag))
+            if flag.choices is not None:
+                reply = f'COMPREPLY=($(compgen -W "{" ".join(flag.choices)}" -- "$cur"))'
+            elif flag.type == "path":
+                reply = 'COMPREPLY=($(compgen -f -- "$cur"))'
+            else:
+                reply = "COMPREPLY=()"
+            value_cases.append(f"        {patterns}) {reply}; return ;;")
+        words = [option for flag in command.flags for option in _options(flag)]
+        for argument in command.arguments:
+            words[:0] = list(argument.choices or ())
+        if command is spec:
+            words[:0] = [_subcommand_name(child) for child in spec.commands]
+        pattern = "*" if command is spec else key
+        reply = f'COMPREPLY=($(compgen -W "{" ".join(words)}" -- "$cur"))'
+        word_cases.append(f"        {pattern}) {reply} ;;")
+    root_case = word_cases.pop(0)
+    lines = [
+        f"# bash completion for {spec.name}; generated by `{spec.name} completion bash`.",
+        f"{function}() {{",
+        '    local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}"',
+        '    local command="" word',
+        '    for word in "${COMP_WORDS[@]:1:COMP_CWORD-1}"; do',
+        '        case "$word" in',
+        f'            {names.replace(" ", "|")}) command="$word"; break ;;',
+        "        esac",
+        "    done",
+        '    case "$command:$prev" in',
+        *value_cases,
+        "    esac",
+        '    case "$command" in',
+        *word_cases,
+        root_case,
+        "    esac",
+        "}",
+        f"complete -F {function} {spec.name}",
+    ]
+    return "\n".join(lines) + "\n"
+
+
+def _zsh_script(spec: CommandSpec) -> str:
+    function = f"_{_identifier(spec.name)}"
+    lines = [
+        f"#compdef {spec.name}",
+        f"# zsh completion for {spec.name}; generated by `{spec.name} completion zsh`.",
+        "",
+        f"{function}() {{",
+        '    local curcontext="$curcontext" state line',
+        "    typeset -A opt_args",
+        "    _arguments -C \\",
+        *(f"        {entry} \\" for entry in _zsh_flag_entries(spec)),
+        "        '1: :->command' \\",
+        "        '*:: :->args'",
+        "    case $state in",
+        "        command)",
+        "            local -a commands",
+        "            commands=(",
+        *(
+            f"                '{_subcommand_name(child)}:{_zsh_quote(child.help)}'"
+            for child in spec.commands
+        ),
+        "            )",
+        "            _describe command commands",
+        "            ;;",
+        "        args)",
+        "            case $line[1] in",
+    ]
+    for child in spec.commands:
+        entries = _zsh_flag_entries(child)
+        for position, argument in enumerate(child.arguments, start=1):
+            action = f"({' '.join(argument.choices)})" if argument.choices else " "
+            entries.append(f"'{position}:{_zsh_escape(argument.name)}:{action}'")
+        lines.append(f"                {_subcommand_name(child)})")
+        lines.append("                    _arguments \\")
+        lines.extend(f"                        {entry} \\" for entry in entries[:-1])
+        lines.append(f"                        {entries[-1]}")
+        lines.append("                    ;;")
+    lines.extend(
+        [
+            "            esac",
+            "            ;;",
+            "    esac",
+            "}",
+            "",
+            f'if [ "$funcstack[1]" = "{function}" ]; then',
+            f'    {function} "$@"',
+            "else",
+            f"    compdef {function} {spec.name}",
+            "fi",
+        ]
+    )
+    return "\n".join(lines) + "\n"
+
+
+def _zsh_flag_entries(command: CommandSpec) -> list[str]:
+    entries: list[str] = []
+    for flag in command.flags:
+        repeat = "*" if flag.repeatable else ""
+        if flag.type == "boolean":
+            value = ""
+        elif flag.choices is not None:
+            value = f":{_zsh_escape(_value_label(flag))}:({' '.join(flag.choices)})"
+        elif flag.type == "path":
+            value = f":{_zsh_escape(flag.metavar or 'path')}:_files"
+        else:
+            value = f":{_zsh_escape(flag.metavar or 'value')}: "
+        for option in _options(flag):
+            entries.append(f"'{repeat}{option}[{_zsh_help(flag.help)}]{value}'")
+    return entries
+
+
+def _zsh_help(text: str) -> str:
+    escaped = text.replace("\\", "\\\\").replace("[", "\\[").replace("]", "\\]")
+    return _zsh_quote(escaped)
+
+
+def _zsh_escape(text: str) -> str:
+    return _zsh_quote(text.replace("\\", "\\\\").replace(":", "\\:"))
+
+
+def _zsh_quote(text: str) -> str:
+    return text.replace("'", "'\\''")
+
+
+def _fish_script(spec: CommandSpec) -> str:
+    prefix = f"complete -c {spec.name}"
+    root_condition = "-n __fish_use_subcommand"
+    lines = [
+        f"# fish completion for {spec.name}; generated by `{spec.name} completion fish`.",
+        f"{prefix} -f",
+    ]
+    for child in spec.commands:
+        lines.append(
+            f"{prefix} {root_condition} -a {_subcommand_name(child)} -d {_fish_quote(child.help)}"
+        )
+    lines.extend(_fish_flag_lines(prefix, root_condition, spec))
+    for child in spec.commands:
+        condition = f"-n '__fish_seen_subcommand_from {_subcommand_name(child)}'"
+        for argument in child.arguments:
+            if argument.choices:
+                choices = _fish_quote(" ".join(argument.choices))
+                lines.append(
+                    f"{prefix} {condition} -a {choices} -d {_fish_quote(argument.help)}"
+                )
+        lines.extend(_fish_flag_lines(prefix, condition, child))
+    return "\n".join(lines) + "\n"
+
+
+def _fish_flag_lines(prefix: str, condition: str, command: CommandSpec) -> list[str]:
+    lines: list[str] = []
+    for flag in command.flags:
+        parts = [prefix, condition]
+        for option in _options(flag):
+            parts.append(f"-l {option[2:]}" if option.startswith("--") else f"-s {option[1:]}")
+        if flag.choices is not None:
+            parts.append(f"-x -a {_fish_quote(' '.join(flag.choices))}")
+        elif flag.type == "path":
+            parts.append("-r -F")
+        elif flag.type != "boolean":
+            parts.append("-x")
+        parts.append(f"-d {_fish_quote(flag.help)}")
+        lines.append(" ".join(parts))
+    return lines
+
+
+def _fish_quote(text: str) -> str:
+    escaped = text.replace("\\", "\\\\").replace("'", "\\'")
+    return f"'{escaped}'"
+
+
+def _identifier(name: str) -> str:
+    return "".join(char if char.isalnum() else "_" for char in name)
@@ -2,8 +2,9 @@
 
 from __future__ import annotations
 
+import re
 import tomllib
-from dataclasses import dataclass
+from dataclasses import dataclass, replace
 from pathlib import Path
 
 from repo_mcp.security import SecurityLimits
@@ -13,6 +14,72 @@ MAX_OPEN_LINES_CAP = 2_000
 MAX_TOTAL_BYTES_PER_RESPONSE_CAP = 1024 * 1024
 MAX_SEARCH_HITS_CAP = 200
 MAX_REFERENCES_CAP = 200
+MAX_SCAN_CONCURRENCY_CAP = 64
+DEFAULT_WATCH_DEBOUNCE_MS = 300
+MAX_WATCH_DEBOUNCE_MS = 60_000
+OUTPUT_FORMATS = ("json", "jsonl", "markdown", "sarif", "dot", "sqlite")
+GRAPH_LEVELS = ("package", "file", "symbol")
+PROGRESS_MODES = ("auto", "always", "never")
+SYMBOL_KIND_GROUPS: dict[str, frozenset[str]] = {
+    "constant": frozenset({"const", "constant"}),
+    "enum": frozenset({"enum"}),
+    "field": frozenset({"embedded", "event", "field", "property", "variant"}),
+    "function": frozenset({"async_function", "function"}),
+    "impl": frozenset({"impl"}),
+    "interface": frozenset({"interface", "trait"}),
+    "method": frozenset({"async_method", "constructor", "method"}),
+    "module": frozenset({"mod", "module", "namespace"}),
+    "type": frozenset({"class", "object", "record", "struct", "type", "type_alias"}),
+    "variable": frozenset({"exported_variable", "static", "var", "variable"}),
+}
+SYMBOL_KINDS = tuple(sorted(SYMBOL_KIND_GROUPS))
+GO_OS_VALUES = (
+    "aix",
+    "android",
+    "darwin",
+    "dragonfly",
+    "freebsd",
+    "hurd",
+    "illumos",
+    "ios",
+    "js",
+    "linux",
+    "nacl",
+    "netbsd",
+    "openbsd",
+    "plan9",
+    "solaris",
+    "wasip1",
+    "windows",
+    "zos",
+)
+GO_ARCH_VALUES = (
+    "386",
+    "amd64",
+    "amd64p32",
+    "arm",
+    "armbe",
+    "arm64",
+    "arm64be",
+    "loong64",
+    "mips",
+    "mipsle",
+    "mips64",
+    "mips64le",
+    "mips64p32",
+    "mips64p32le",
+    "ppc",
+    "ppc64",
+    "ppc64le",
+    "riscv",
+    "riscv64",
+    "s390",
+    "s390x",
+    "sparc",
+    "sparc64",
+    "wasm",
+)
+REPO_CONFIG_FILENAME = "repo_mcp.toml"
 
 DEFAULT_INCLUDE_EXTENSIONS = (
     ".py",
@@ -31,6 +98,7 @@ DEFAULT_INCLUDE_EXTENSIONS = (
     ".hpp",
     ".cxx",
     ".cs",
+    ".kt",
     ".md",
     ".rst",
     ".toml",
@@ -80,6 +148,8 @@ class IndexConfig:
 
     include_extensions: tuple[str, ...]
     exclude_globs: tuple[str, ...]
+    include_globs: tuple[str, ...] = ()
+    respect_gitignore: bool = True
 
 
 @dataclass(slots=True, frozen=True)
@@ -89,6 +159,42 @@ class AdaptersConfig:
     python_enabled: bool
 
 
+@dataclass(slots=True, frozen=True)
+class ScanConfig:
+    """Repository-wide symbol scan settings."""
+
+    concurrency: int | None = None
+    skip_tests: bool = False
+    watch_debounce_ms: int = DEFAULT_WATCH_DEBOUNCE_MS
+    goos: str | None = None
+    goarch: str | None = None
+    strict: bool = False
+    kinds: tuple[str, ...] | None = None
+    max_file_size: int | None = None
+
+
+@dataclass(slots=True, frozen=True)
+class OutputConfig:
+    """Default output settings for tools that accept per-call overrides."""
+
+    format: str = "json"
+    public_only: bool = False
+    deprecated_only: bool = False
+    graph_level: str = "package"
+    progress: str = "auto"
+    group_by_type: bool = False
+    min_complexity: int | None = None
+    db_path: Path | None = None
+
+
+@dataclass(slots=True, frozen=True)
+class SecurityConfig:
+    """Opt-in protections layered on top of the fixed denylist."""
+
+    redact: bool = False
+    redact_patterns: tuple[str, ...] = ()
+
+
 @dataclass(slots=True, frozen=True)
 class ServerConfig:
     """Fully merged server configuration."""
@@ -98,6 +204,9 @@ class ServerConfig:
     limits: SecurityLimits
     index: IndexConfig
     adapters: AdaptersConfig
+    scan: ScanConfig = ScanConfig()
+    output: OutputConfig = OutputConfig()
+    security: SecurityConfig = SecurityConfig()
 
     def to_public_dict(self) -> dict[str, object]:
         """Return serializable config snapshot for tool responses."""
@@ -114,10 +223,36 @@ class ServerConfig:
             "index": {
                 "include_extensions": list(self.index.include_extensions),
                 "exclude_globs": list(self.index.exclude_globs),
+                "include_globs": list(self.index.include_globs),
+                "respect_gitignore": self.index.respect_gitignore,
             },
             "adapters": {
                 "python_enabled": self.adapters.python_enabled,
             },
+            "scan": {
+                "concurrency": self.scan.concurrency,
+                "skip_tests": self.scan.skip_tests,
+                "watch_debounce_ms": self.scan.watch_debounce_ms,
+                "goos": self.scan.goos,
+                "goarch": self.scan.goarch,
+                "strict": self.scan.strict,
+                "kinds": list(self.scan.kinds) if self.scan.kinds is not None else None,
+                "max_file_size": self.scan.max_file_size,
+            },
+            "output": {
+                "format": self.output.format,
+                "public_only": self.output.public_only,
+                "deprecated_only": self.output.deprecated_only,
+                "graph_level": self.output.graph_level,
+                "progress": self.output.progress,
+                "group_by_type": self.output.group_by_type,
+                "min_complexity": self.output.min_complexity,
+                "db_path": str(self.output.db_path) if self.output.db_path is not None else None,
+            },
+            "security": {
+                "redact": self.security.redact,
+                "redact_patterns": list(self.security.redact_patterns),
+            },
         }
 
 
@@ -132,6 +267,67 @@ class CliOverrides:
     max_search_hits: int | None = None
     max_references: int | None = None
     python_enabled: bool | None = None
+    concurrency: int | None = None
+    exclude_globs: tuple[str, ...] = ()
+    include_globs: tuple[str, ...] = ()
+    respect_gitignore: bool | None = None
+    skip_tests: bool | None = None
+    watch_debounce_ms: int | None = None
+    goos: str | None = None
+    goarch: str | None = None
+    strict: bool | None = None
+    kinds: tuple[str, ...] | None = None
+    max_file_size: int | None = None
+    output_format: str | None = None
+    public_only: bool | None = None
+    deprecated_only: bool | None = None
+    graph_level: str | None = None
+    progress: str | None = None
+    group_by_type: bool | None = None
+    min_complexity: int | None = None
+    db_path: Path | None = None
+    redact: bool | None = None
+
+
+_CONFIG_KEYS: dict[str, frozenset[str]] = {
+    "adapters": frozenset({"python_enabled"}),
+    "index": frozenset(
+        {"include_extensions", "exclude_globs", "include_globs", "respect_gitignore"}
+    ),
+    "limits": frozenset(
+        {
+            "max_file_bytes",
+            "max_open_lines",
+            "max_total_bytes_per_response",
+            "max_search_hits",
+            "max_references",
+        }
+    ),
+    "output": frozenset(
+        {
+            "deprecated_only",
+            "format",
+            "graph_level",
+            "group_by_type",
+            "min_complexity",
+            "progress",
+            "public_only",
+        }
+    ),
+    "scan": frozenset(
+        {
+            "concurrency",
+            "goarch",
+            "goos",
+            "kinds",
+            "max_file_size",
+            "skip_tests",
+            "strict",
+            "watch_debounce_ms",
+        }
+    ),
+    "security": frozenset({"redact", "redact_patterns"}),
+}
 
 
 def default_config(repo_root: Path) -> ServerConfig:
@@ -149,18 +345,38 @@ def default_config(repo_root: Path) -> ServerConfig:
     )
 
 
-def load_repo_config_file(repo_root: Path) -> dict[str, object]:
-    """Load optional repo_mcp.toml from repo root."""
-    config_path = repo_root / "repo_mcp.toml"
-    if not config_path.exists():
+def load_repo_config_file(repo_root: Path, config_path: Path | None = None) -> dict[str, object]:
+    """Load optional repo_mcp.toml from repo root, or a required file at config_path."""
+    path = config_path if config_path is not None else repo_root / REPO_CONFIG_FILENAME
+    if not path.exists():
+        if config_path is not None:
+            raise ValueError(f"Config file not found: {config_path}")
         return {}
-    with config_path.open("rb") as handle:
+    with path.open("rb") as handle:
         payload = tomllib.load(handle)
     if not isinstance(payload, dict):
-        raise ValueError("repo_mcp.toml must contain a top-level table.")
+        raise ValueError(f"{path.name} must contain a top-level table.")
     return payload
 
 
+def _reject_unknown_keys(payload: dict[str, object]) -> None:
+    for section in sorted(payload):
+        if section not in _CONFIG_KEYS:
+            supported = ", ".join(sorted(_CONFIG_KEYS))
+            raise ValueError(
+                f"Unknown config section '{section}'; supported sections: {supported}."
+            )
+        table = payload[section]
+        if not isinstance(table, dict):
+            continue
+        for key in sorted(table):
+            if key not in _CONFIG_KEYS[section]:
+                supported = ", ".join(sorted(_CONFIG_KEYS[section])) or "none"
+                raise ValueError(
+                    f"Unknown config key '{section}.{key}'; supported keys: {supported}."
+                )
+
+
 def _get_table(payload: dict[str, object], key: str) -> dict[str, object]:
     value = payload.get(key, {})
     if not isinstance(value, dict):
@@ -187,6 +403,8 @@ def merge_config(
     index_payload = _get_table(repo_payload, "index")
     adapters_payload = _get_table(repo_payload, "adapters")
     security_payload = _get_table(repo_payload, "security")
+    scan_payload = _get_table(repo_payload, "scan")
+    output_payload = _get_table(repo_payload, "output")
 
     if "denylist_override" in security_payload:
         raise ValueError(
@@ -203,6 +421,7 @@ def merge_config(
             "Config field 'security.denylist_relax' is not supported in v1; "
             "default denylist cannot be relaxed."
         )
+    _reject_unknown_keys(repo_payload)
 
     max_file_bytes = _optional_positive_int_with_cap(
         limits_payload.get("max_file_bytes"),
@@ -243,6 +462,15 @@ def merge_config(
     exclude_globs = base.index.exclude_globs
     if "exclude_globs" in index_payload:
         exclude_globs = _tuple_of_strings(index_payload["exclude_globs"], "index", "exclude_globs")
+    include_globs = base.index.include_globs
+    if "include_globs" in index_payload:
+        include_globs = _tuple_of_strings(index_payload["include_globs"], "index", "include_globs")
+    respect_gitignore = base.index.respect_gitignore
+    if "respect_gitignore" in index_payload:
+        raw_respect_gitignore = index_payload["respect_gitignore"]
+        if not isinstance(raw_respect_gitignore, bool):
+            raise ValueError("Config field 'index.respect_gitignore' must be a boolean.")
+        respect_gitignore = raw_respect_gitignore
 
     python_enabled = base.adapters.python_enabled
     if "python_enabled" in adapters_payload:
@@ -251,6 +479,103 @@ def merge_config(
             raise ValueError("Config field 'adapters.python_enabled' must be a boolean.")
         python_enabled = raw_python_enabled
 
+    concurrency = base.scan.concurrency
+    if "concurrency" in scan_payload:
+        concurrency = _optional_positive_int_with_cap(
+            scan_payload["concurrency"],
+            "scan.concurrency",
+            1,
+            MAX_SCAN_CONCURRENCY_CAP,
+        )
+    skip_tests = base.scan.skip_tests
+    if "skip_tests" in scan_payload:
+        raw_skip_tests = scan_payload["skip_tests"]
+        if not isinstance(raw_skip_tests, bool):
+            raise ValueError("Config field 'scan.skip_tests' must be a boolean.")
+        skip_tests = raw_skip_tests
+    watch_debounce_ms = base.scan.watch_debounce_ms
+    if "watch_debounce_ms" in scan_payload:
+        watch_debounce_ms = _optional_positive_int_with_cap(
+            scan_payload["watch_debounce_ms"],
+            "scan.watch_debounce_ms",
+            DEFAULT_WATCH_DEBOUNCE_MS,
+            MAX_WATCH_DEBOUNCE_MS,
+        )
+    goos = base.scan.goos
+    if "goos" in scan_payload:
+        goos = _go_target_value(scan_payload["goos"], "scan.goos", GO_OS_VALUES)
+    goarch = base.scan.goarch
+    if "goarch" in scan_payload:
+        goarch = _go_target_value(scan_payload["goarch"], "scan.goarch", GO_ARCH_VALUES)
+    strict = base.scan.strict
+    if "strict" in scan_payload:
+        raw_strict = scan_payload["strict"]
+        if not isinstance(raw_strict, bool):
+            raise ValueError("Config field 'scan.strict' must be a boolean.")
+        strict = raw_strict
+    kinds = base.scan.kinds
+    if "kinds" in scan_payload:
+        raw_kinds = scan_payload["kinds"]
+        kinds = (
+            None
+            if raw_kinds is None
+            else _symbol_kinds(_tuple_of_strings(raw_kinds, "scan", "kinds"), "scan.kinds")
+        )
+    max_file_size = base.scan.max_file_size
+    if "max_file_size" in scan_payload:
+        raw_max_file_size = scan_payload["max_file_size"]
+        max_file_size = (
+            None
+            if raw_max_file_size is None
+            else _optional_positive_int_with_cap(
+                raw_max_file_size, "scan.max_file_size", 1, MAX_FILE_BYTES_CAP
+            )
+        )
+
+    output_format = base.output.format
+    if "format" in output_payload:
+        output_format = _output_format(output_payload["format"], "output.format")
+    public_only = base.output.public_only
+    if "public_only" in output_payload:
+        raw_public_only = output_payload["public_only"]
+        if not isinstance(raw_public_only, bool):
+            raise ValueError("Config field 'output.public_only' must be a boolean.")
+        public_only = raw_public_only
+    deprecated_only = base.output.deprecated_only
+    if "deprecated_only" in output_payload:
+        raw_deprecated_only = output_payload["deprecated_only"]
+        if not isinstance(raw_deprecated_only, bool):
+            raise ValueError("Config field 'output.deprecated_only' must be a boolean.")
+        deprecated_only = raw_deprecated_only
+    graph_level = base.output.graph_level
+    if "graph_level" in output_payload:
+        graph_level = _graph_level(output_payload["graph_level"], "output.graph_level")
+    progress = base.output.progress
+    if "progress" in output_payload:
+        progress = _progress_mode(output_payload["progress"], "output.progress")
+    group_by_type = base.output.group_by_type
+    if "group_by_type" in output_payload:
+        raw_group_by_type = output_payload["group_by_type"]
+        if not isinstance(raw_group_by_type, bool):
+            raise ValueError("Config field 'output.group_by_type' must be a boolean.")
+        group_by_type = raw_group_by_type
+    min_complexity = base.output.min_complexity
+    if "min_complexity" in output_payload:
+        min_complexity = _optional_positive_int_with_cap(
+            output_payload["min_complexity"], "output.min_complexity", 1, None
+        )
+    redact = base.security.redact
+    if "redact" in security_payload:
+        raw_redact = security_payload["redact"]
+        if not isinstance(raw_redact, bool):
+            raise ValueError("Config field 'security.redact' must be a boolean.")
+        redact = raw_redact
+    redact_patterns = base.security.redact_patterns
+    if "redact_patterns" in security_payload:
+        redact_patterns = _redact_patterns(
+            _tuple_of_strings(security_payload["redact_patterns"], "security", "redact_patterns")
+        )
+
     merged = ServerConfig(
         repo_root=base.repo_root,
         data_dir=base.data_dir,
@@ -264,8 +589,30 @@ def merge_config(
         index=IndexConfig(
             include_extensions=include_extensions,
             exclude_globs=exclude_globs,
+            include_globs=include_globs,
+            respect_gitignore=respect_gitignore,
         ),
         adapters=AdaptersConfig(python_enabled=python_enabled),
+        scan=ScanConfig(
+            concurrency=concurrency,
+            skip_tests=skip_tests,
+            watch_debounce_ms=watch_debounce_ms,
+            goos=goos,
+            goarch=goarch,
+            strict=strict,
+            kinds=kinds,
+            max_file_size=max_file_size,
+        ),
+        output=OutputConfig(
+            format=output_format,
+            public_only=public_only,
+            deprecated_only=deprecated_only,
+            graph_level=graph_level,
+            progress=progress,
+            group_by_type=group_by_type,
+            min_complexity=min_complexity,
+        ),
+        security=SecurityConfig(redact=redact, redact_patterns=redact_patterns),
     )
     return apply_cli_overrides(merged, overrides)
 
@@ -317,24 +664,175 @@ def apply_cli_overrides(config: ServerConfig, overrides: CliOverrides) -> Server
             else config.adapters.python_enabled
         )
     )
+    scan = config.scan
+    if overrides.concurrency is not None:
+        scan = replace(
+            scan,
+            concurrency=_optional_positive_int_with_cap(
+                overrides.concurrency,
+                "overrides.concurrency",
+                1,
+                MAX_SCAN_CONCURRENCY_CAP,
+            ),
+        )
+    if overrides.skip_tests is not None:
+        scan = replace(scan, skip_tests=overrides.skip_tests)
+    if overrides.watch_debounce_ms is not None:
+        scan = replace(
+            scan,
+            watch_debounce_ms=_optional_positive_int_with_cap(
+                overrides.watch_debounce_ms,
+                "overrides.watch_debounce_ms",
+                DEFAULT_WATCH_DEBOUNCE_MS,
+                MAX_WATCH_DEBOUNCE_MS,
+            ),
+        )
+    if overrides.goos is not None:
+        scan = replace(
+            scan, goos=_go_target_value(overrides.goos, "overrides.goos", GO_OS_VALUES)
+        )
+    if overrides.goarch is not None:
+        scan = replace(
+            scan, goarch=_go_target_value(overrides.goarch, "overrides.goarch", GO_ARCH_VALUES)
+        )
+    if overrides.strict is not None:
+        scan = replace(scan, strict=overrides.strict)
+    if overrides.kinds is not None:
+        scan = replace(scan, kinds=_symbol_kinds(overrides.kinds, "overrides.kinds"))
+    if overrides.max_file_size is not None:
+        scan = replace(
+            scan,
+            max_file_size=_optional_positive_int_with_cap(
+                overrides.max_file_size,
+                "overrides.max_file_size",
+                1,
+                MAX_FILE_BYTES_CAP,
+            ),
+        )
+    output = config.output
+    if overrides.output_format is not None:
+        output = replace(
+            output, format=_output_format(overrides.output_format, "overrides.output_format")
+        )
+    if overrides.public_only is not None:
+        output = replace(output, public_only=overrides.public_only)
+    if overrides.deprecated_only is not None:
+        output = replace(output, deprecated_only=overrides.deprecated_only)
+    if overrides.graph_level is not None:
+        output = replace(
+            output, graph_level=_graph_level(overrides.graph_level, "overrides.graph_level")
+        )
+    if overrides.progress is not None:
+        output = replace(output, progress=_progress_mode(overrides.progress, "overrides.progress"))
+    if overrides.group_by_type is not None:
+        output = replace(output, group_by_type=overrides.group_by_type)
+    if overrides.min_complexity is not None:
+        output = replace(
+            output,
+            min_complexity=_optional_positive_int_with_cap(
+                overrides.min_complexity, "overrides.min_complexity", 1, None
+            ),
+        )
+    if overrides.db_path is not None:
+        output = replace(output, db_path=overrides.db_path.resolve())
+    security = config.security
+    if overrides.redact is not None:
+        security = replace(security, redact=overrides.redact)
+    index = IndexConfig(
+        include_extensions=config.index.include_extensions,
+        exclude_globs=config.index.exclude_globs + tuple(overrides.exclude_globs),
+        include_globs=tuple(overrides.include_globs) or config.index.include_globs,
+        respect_gitignore=(
+            overrides.respect_gitignore
+            if overrides.respect_gitignore is not None
+            else config.index.respect_gitignore
+        ),
+    )
     data_dir = overrides.data_dir or config.data_dir
     return ServerConfig(
         repo_root=config.repo_root,
         data_dir=data_dir.resolve(),
         limits=limits,
-        index=config.index,
+        index=index,
         adapters=adapters,
+        scan=scan,
+        output=output,
+        security=security,
     )
 
 
-def load_effective_config(repo_root: Path, overrides: CliOverrides | None = None) -> ServerConfig:
-    """Load effective config using merge order defaults -> repo config -> overrides."""
+def load_effective_config(
+    repo_root: Path,
+    overrides: CliOverrides | None = None,
+    config_path: Path | None = None,
+) -> ServerConfig:
+    """Load effective config using merge order defaults -> repo config -> overrides.
+
+    config_path replaces `<repo_root>/repo_mcp.toml` and must exist.
+    """
     resolved_root = repo_root.resolve()
     base = default_config(resolved_root)
-    payload = load_repo_config_file(resolved_root)
+    payload = load_repo_config_file(resolved_root, config_path)
     return merge_config(base, payload, overrides or CliOverrides())
 
 
+def _output_format(value: object, name: str) -> str:
+    if not isinstance(value, str) or value not in OUTPUT_FORMATS:
+        allowed = ", ".join(OUTPUT_FORMATS)
+        raise ValueError(f"Config field '{name}' must be one of: {allowed}.")
+    return value
+
+
+def _graph_level(value: object, name: str) -> str:
+    if not isinstance(value, str) or value not in GRAPH_LEVELS:
+        allowed = ", ".join(GRAPH_LEVELS)
+        raise ValueError(f"Config field '{name}' must be one of: {allowed}.")
+    return value
+
+
+def _progress_mode(value: object, name: str) -> str:
+    if not isinstance(value, str) or value not in PROGRESS_MODES:
+        allowed = ", ".join(PROGRESS_MODES)
+        raise ValueError(f"Config field '{name}' must be one of: {allowed}.")
+    return value
+
+
+def _symbol_kinds(values: tuple[str, ...], name: str) -> tuple[str, ...]:
+    """Return the allowlisted kind names sorted and de-duplicated."""
+    allowed = ", ".join(SYMBOL_KINDS)
+    unknown = sorted(set(values) - set(SYMBOL_KINDS))
+    if unknown:
+        raise ValueError(
+            f"Config field '{name}' has unknown kind {unknown[0]!r}; expected one of: {allowed}."
+        )
+    if not values:
+        raise ValueError(f"Config field '{name}' must list at least one of: {allowed}.")
+    return tuple(sorted(set(values)))
+
+
+def _redact_patterns(patterns: tuple[str, ...]) -> tuple[str, ...]:
+    for position, pattern in enumerate(patterns, start=1):
+        try:
+            re.compile(pattern)
+        except re.error as error:
+            raise ValueError(
+                f"Config field 'security.redact_patterns' entry {position} is not a valid "
+                f"regular expression: {error}."
+            ) from error
+        if re.fullmatch(pattern, "") is not None:
+            raise ValueError(
+                f"Config field 'security.redact_patterns' entry {position} must not match "
+                "the empty string."
+            )
+    return patterns
+
+
+def _go_target_value(value: object, name: str, known: tuple[str, ...]) -> str:
+    if not isinstance(value, str) or value not in known:
+        raise ValueError(f"Config field '{name}' must be a known Go value, got {value!r}.")
+    return value
+
+
 def _optional_positive_int(value: object, name: str, default: int) -> int:
     return _optional_positive_int_with_cap(value, name, default, cap=None)
 
@@ -10,6 +10,7 @@ from dataclasses import asdict, dataclass
 from pathlib import Path
 
 from repo_mcp.config import IndexConfig
+from repo_mcp.index.gitignore import GitignoreRule, is_ignored, load_gitignore
 from repo_mcp.index.models import FileRecord, IndexDelta
 
 _BINARY_SNIFF_BYTES = 4096
@@ -22,6 +23,8 @@ class DiscoveryProfile:
     total_candidates: int
     excluded_by_glob: int
     excluded_by_extension: int
+    excluded_by_gitignore: int
+    excluded_by_include: int
     unchanged_reused: int
     binary_excluded: int
     hashed_files: int
@@ -49,6 +52,8 @@ class _DiscoveryScanResult:
     total_candidates: int
     excluded_by_glob: int
     excluded_by_extension: int
+    excluded_by_gitignore: int
+    excluded_by_include: int
 
 
 def discover_files(
@@ -77,6 +82,8 @@ def discover_files(
         include_extensions=include_extensions,
         exclude_globs=config.exclude_globs,
         excluded_dir_names=excluded_dir_names,
+        include_globs=config.include_globs,
+        respect_gitignore=config.respect_gitignore,
     )
     candidates = list(scan.candidates)
     total_candidates = scan.total_candidates
@@ -128,6 +135,8 @@ def discover_files(
             total_candidates=total_candidates,
             excluded_by_glob=excluded_by_glob,
             excluded_by_extension=excluded_by_extension,
+            excluded_by_gitignore=scan.excluded_by_gitignore,
+            excluded_by_include=scan.excluded_by_include,
             unchanged_reused=reused,
             binary_excluded=binary_excluded,
             hashed_files=hashed_files,
@@ -182,6 +191,13 @@ def should_exclude(relative_path: str, exclude_globs: tuple[str, ...]) -> bool:
     )
 
 
+def matches_include(relative_path: str, include_globs: tuple[str, ...]) -> bool:
+    """Return True when no include globs are set or the path matches one of them."""
+    if not include_globs:
+        return True
+    return should_exclude(relative_path, include_globs)
+
+
 def has_allowed_extension(relative_path: str, include_extensions: tuple[str, ...]) -> bool:
     """Return True when file extension is included."""
     suffix = Path(relative_path).suffix.lower()
@@ -209,27 +225,42 @@ def _discover_candidates(
     include_extensions: set[str],
     exclude_globs: tuple[str, ...],
     excluded_dir_names: set[str],
+    include_globs: tuple[str, ...],
+    respect_gitignore: bool,
 ) -> _DiscoveryScanResult:
-    """Walk tree deterministically with light pruning for excluded directories."""
+    """Walk tree deterministically with light pruning for excluded directories.
+
+    When respect_gitignore is set, each directory's .gitignore is loaded as the
+    walk enters it and its rules apply beneath that directory, after the rules
+    inherited from its ancestors.
+    """
     candidates: list[_CandidateFile] = []
     total_candidates = 0
     excluded_by_glob = 0
     excluded_by_extension = 0
-    stack: list[Path] = [root]
+    excluded_by_gitignore = 0
+    excluded_by_include = 0
+    stack: list[tuple[Path, tuple[GitignoreRule, ...]]] = [(root, ())]
     while stack:
-        current = stack.pop()
+        current, inherited_rules = stack.pop()
         try:
             with os.scandir(current) as entries:
                 ordered_entries = sorted(entries, key=lambda item: item.name)
         except OSError:
             continue
+        rules = inherited_rules
+        if respect_gitignore:
+            base = "" if current == root else current.relative_to(root).as_posix()
+            rules = inherited_rules + load_gitignore(current, base)
         for entry in reversed(ordered_entries):
             full_path = Path(entry.path)
             relative = full_path.relative_to(root).as_posix()
             if entry.is_dir(follow_symlinks=False):
                 if entry.name in excluded_dir_names and should_exclude(relative, exclude_globs):
                     continue
-                stack.append(full_path)
+                if rules and is_ignored(rules, relative, is_dir=True):
+                    continue
+                stack.append((full_path, rules))
                 continue
             if not entry.is_file(follow_symlinks=False):
                 continue
@@ -237,6 +268,12 @@ def _discover_candidates(
             if should_exclude(relative, exclude_globs):
                 excluded_by_glob += 1
                 continue
+            if rules and is_ignored(rules, relative, is_dir=False):
+                excluded_by_gitignore += 1
+                continue
+            if not matches_include(relative, include_globs):
+                excluded_by_include += 1
+                continue
             suffix = Path(relative).suffix.lower()
             if suffix not in include_extensions:
                 excluded_by_extension += 1
@@ -258,6 +295,8 @@ def _discover_candidates(
         total_candidates=total_candidates,
         excluded_by_glob=excluded_by_glob,
         excluded_by_extension=excluded_by_extension,
+        excluded_by_gitignore=excluded_by_gitignore,
+        excluded_by_include=excluded_by_include,
     )
 
 
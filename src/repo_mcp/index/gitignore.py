@@ -0,0 +1,141 @@
+"""Deterministic .gitignore pattern parsing and matching."""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass
+from pathlib import Path
+
+GITIGNORE_FILENAME = ".gitignore"
+
+
+@dataclass(slots=True, frozen=True)
+class GitignoreRule:
+    """One compiled .gitignore pattern scoped to the directory that declared it."""
+
+    base: str
+    pattern: str
+    regex: re.Pattern[str]
+    negated: bool
+    dir_only: bool
+
+
+def parse_gitignore(text: str, base: str = "") -> tuple[GitignoreRule, ...]:
+    """Parse .gitignore text into ordered rules relative to base (repo-relative dir)."""
+    rules: list[GitignoreRule] = []
+    for raw_line in text.splitlines():
+        rule = _parse_line(raw_line, base)
+        if rule is not None:
+            rules.append(rule)
+    return tuple(rules)
+
+
+def load_gitignore(directory: Path, base: str) -> tuple[GitignoreRule, ...]:
+    """Load rules from directory/.gitignore, returning no rules when absent or unreadable."""
+    path = directory / GITIGNORE_FILENAME
+    try:
+        text = path.read_text(encoding="utf-8", errors="replace")
+    except OSError:
+        return ()
+    return parse_gitignore(text, base)
+
+
+def is_ignored(rules: tuple[GitignoreRule, ...], relative_path: str, *, is_dir: bool) -> bool:
+    """Return True when the last matching rule for relative_path is not a negation.
+
+    Rules must be ordered from the repository root towards the deepest
+    directory so that nested .gitignore files take precedence, as git does.
+    """
+    ignored = False
+    for rule in rules:
+        if rule.dir_only and not is_dir:
+            continue
+        if rule.base:
+            prefix = f"{rule.base}/"
+            if not relative_path.startswith(prefix):
+                continue
+            candidate = relative_path[len(prefix) :]
+        else:
+            candidate = relative_path
+        if rule.regex.match(candidate) is not None:
+            ignored = not rule.negated
+    return ignored
+
+
+def _parse_line(raw_line: str, base: str) -> GitignoreRule | None:
+    line = _strip_trailing_spaces(raw_line)
+    if not line or line.startswith("#"):
+        return None
+    negated = False
+    if line.startswith("!"):
+        negated = True
+        line = line[1:]
+    elif line.startswith("\\!") or line.startswith("\\#"):
+        line = line[1:]
+    dir_only = line.endswith("/")
+    line = line.rstrip("/")
+    if not line:
+        return None
+    anchored = "/" in line
+    line = line.lstrip("/")
+    if not line:
+        return None
+    body = _glob_to_regex(line)
+    regex_text = f"^{body}$" if anchored else f"^(?:.*/)?{body}$"
+    return GitignoreRule(
+        base=base,
+        pattern=raw_line.strip(),
+        regex=re.compile(regex_text),
+        negated=negated,
+        dir_only=dir_only,
+    )
+
+
+def _strip_trailing_spaces(line: str) -> str:
+    stripped = line.rstrip("\n").rstrip("\r")
+    while stripped.endswith(" ") and not stripped.endswith("\\ "):
+        stripped = stripped[:-1]
+    if stripped.endswith("\\ "):
+        stripped = stripped[:-2] + " "
+    return stripped
+
+
+def _glob_to_regex(pattern: str) -> str:
+    parts: list[str] = []
+    index = 0
+    length = len(pattern)
+    while index < length:
+        char = pattern[index]
+        if pattern.startswith("**/", index) and (index == 0 or pattern[index - 1] == "/"):
+            parts.append("(?:.*/)?")
+            index += 3
+            continue
+        if pattern.startswith("/**", index) and index + 3 == length:
+            parts.append("/.*")
+            index += 3
+            continue
+        if pattern.startswith("**", index):
+            parts.append(".*")
+            index += 2
+            continue
+        if char == "*":
+            parts.append("[^/]*")
+        elif char == "?":
+            parts.append("[^/]")
+        elif char == "[":
+            closing = pattern.find("]", index + 1)
+            if closing == -1:
+                parts.append(re.escape(char))
+            else:
+                klass = pattern[index + 1 : closing]
+                if klass.startswith("!"):
+                    klass = "^" + klass[1:]
+                parts.append(f"[{klass}]")
+                index = closing
+        elif char == "\\" and index + 1 < length:
+            index += 1
+            parts.append(re.escape(pattern[index]))
+        else:
+            parts.append(re.escape(char))
+        index += 1
+    return "".join(parts)
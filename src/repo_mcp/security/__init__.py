@@ -8,10 +8,14 @@ from .policy import (
     enforce_open_line_limits,
     is_denylisted,
 )
+from .redaction import BUILTIN_REDACTION_PATTERNS, REDACTED, Redactor
 
 __all__ = [
+    "BUILTIN_REDACTION_PATTERNS",
+    "REDACTED",
     "PathBlockedError",
     "PolicyBlockedError",
+    "Redactor",
     "SecurityLimits",
     "enforce_file_access_policy",
     "enforce_open_line_limits",
@@ -0,0 +1,100 @@
+"""Deterministic redaction of secret-like text before it is serialized."""
+
+from __future__ import annotations
+
+import hashlib
+import math
+import re
+from collections import Counter
+from collections.abc import Iterable, Sequence
+
+REDACTED = "***REDACTED***"
+HIGH_ENTROPY_MIN_LENGTH = 20
+HIGH_ENTROPY_MIN_BITS = 4.0
+BUILTIN_REDACTION_PATTERNS: tuple[tuple[str, str], ...] = (
+    ("aws_access_key_id", r"\b(?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16}\b"),
+    ("github_token", r"\bgh[pousr]_[A-Za-z0-9]{36,}"),
+    ("jwt", r"\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]*"),
+    (
+        "private_key",
+        r"-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?(?:-----END [A-Z ]*PRIVATE KEY-----|\Z)",
+    ),
+    ("slack_token", r"\bxox[abeprs]-[A-Za-z0-9-]{10,}"),
+)
+_HIGH_ENTROPY_CANDIDATE_RE = re.compile(rf"[A-Za-z0-9+/=_-]{{{HIGH_ENTROPY_MIN_LENGTH},}}")
+_TOKEN_BOUNDARY = frozenset(" \t\r\n\"'`,;()[]{}<>")
+
+
+class Redactor:
+    """Replace secret-like tokens in text with a fixed marker.
+
+    Built-in patterns cover AWS access key IDs, GitHub and Slack tokens, JWTs,
+    and PEM private key blocks; any run of at least 20 base64 or hex
+    characters that mixes letters and digits and carries at least 4 bits of
+    Shannon entropy per character is treated as a secret too. Custom patterns
+    are regular expressions applied the same way. Every match is widened to
+    the whole surrounding token (bounded by whitespace, quotes, and brackets)
+    so a secret is never partially left in place.
+    """
+
+    def __init__(self, patterns: Sequence[str] = ()) -> None:
+        sources = [pattern for _, pattern in BUILTIN_REDACTION_PATTERNS] + list(patterns)
+        self._patterns = tuple(re.compile(source) for source in sources)
+        digest = hashlib.sha256("\x1f".join(sources).encode("utf-8")).hexdigest()
+        self._fingerprint = digest[:16]
+
+    @property
+    def fingerprint(self) -> str:
+        """Return a stable digest of the active patterns, used to key cached output."""
+        return self._fingerprint
+
+    def redact(self, text: str | None) -> str | None:
+        """Return text with every secret-like token replaced, or None for None."""
+        if not text:
+            return text
+        spans: list[tuple[int, int]] = []
+        for pattern in self._patterns:
+            spans.extend(
+                match.span() for match in pattern.finditer(text) if match.end() > match.start()
+            )
+        spans.extend(
+            match.span()
+            for match in _HIGH_ENTROPY_CANDIDATE_RE.finditer(text)
+            if _is_high_entropy(match.group())
+        )
+        if not spans:
+            return text
+        pieces: list[str] = []
+        cursor = 0
+        for start, end in _merge_spans(_widen(text, start, end) for start, end in spans):
+            pieces.append(text[cursor:start])
+            pieces.append(REDACTED)
+            cursor = end
+        pieces.append(text[cursor:])
+        return "".join(pieces)
+
+
+def _widen(text: str, start: int, end: int) -> tuple[int, int]:
+    while start > 0 and text[start - 1] not in _TOKEN_BOUNDARY:
+        start -= 1
+    while end < len(text) and text[end] not in _TOKEN_BOUNDARY:
+        end += 1
+    return start, end
+
+
+def _merge_spans(spans: Iterable[tuple[int, int]]) -> list[tuple[int, int]]:
+    merged: list[tuple[int, int]] = []
+    for start, end in sorted(spans):
+        if merged and start <= merged[-1][1]:
+            merged[-1] = (merged[-1][0], max(end, merged[-1][1]))
+        else:
+            merged.append((start, end))
+    return merged
+
+
+def _is_high_entropy(token: str) -> bool:
+    if not any(char.isdigit() for char in token) or not any(char.isalpha() for char in token):
+        return False
+    counts = Counter(token)
+    bits = -sum(count / len(token) * math.log2(count / len(token)) for count in counts.values())
+    return bits >= HIGH_ENTROPY_MIN_BITS
@@ -9,8 +9,8 @@ import json
 import os
 import sys
 import time
-from collections.abc import Callable
-from dataclasses import asdict
+from collections.abc import Callable, Iterator
+from dataclasses import asdict, replace
 from importlib.metadata import PackageNotFoundError
 from importlib.metadata import version as _pkg_version
 from pathlib import Path
@@ -22,14 +22,26 @@ from repo_mcp.adapters.base import (
     normalize_and_sort_references,
     normalize_and_sort_symbols,
     outline_symbol_matches,
+    outline_symbol_payload,
 )
+from repo_mcp.adapters.go_build import resolve_go_target
 from repo_mcp.bundler import BundleBudget, BundleResult, build_context_bundle
 from repo_mcp.bundler.engine import (
     ReferenceLookupFn,
     ReferenceLookupManyFn,
     ReferenceLookupScopedManyFn,
 )
-from repo_mcp.config import CliOverrides, ServerConfig, load_effective_config
+from repo_mcp.completion import COMPLETION_SHELLS, render_completion, render_flags
+from repo_mcp.config import (
+    GO_ARCH_VALUES,
+    GO_OS_VALUES,
+    GRAPH_LEVELS,
+    OUTPUT_FORMATS,
+    SYMBOL_KINDS,
+    CliOverrides,
+    ServerConfig,
+    load_effective_config,
+)
 from repo_mcp.index import (
     IndexManager,
     IndexSchemaUnsupportedError,
@@ -40,9 +52,48 @@ from repo_mcp.logging import AuditEvent, JsonlAuditLogger, sanitize_arguments, u
 from repo_mcp.security import (
     PathBlockedError,
     PolicyBlockedError,
+    Redactor,
     enforce_file_access_policy,
     resolve_repo_path,
 )
+from repo_mcp.symbols import (
+    DEFAULT_CHARS_PER_TOKEN,
+    DEFAULT_WATCH_POLL_SECONDS,
+    DIFF_INPUT_MAX_BYTES,
+    SYMBOL_CACHE_RELATIVE_PATH,
+    Diagnostic,
+    FileSymbols,
+    ProgressReporter,
+    SkippedFile,
+    SymbolQuery,
+    SymbolSearchIndex,
+    char_ratio_estimator,
+    diff_failed,
+    diff_symbols,
+    export_filename,
+    file_symbols_payload,
+    imports_payload,
+    kind_allowlist,
+    link_symbol_groups,
+    pack_symbols,
+    parse_symbol_export,
+    progress_enabled,
+    query_symbols,
+    redact_symbols,
+    render_export_schema,
+    render_markdown_overview,
+    render_search_markdown,
+    render_summary_markdown,
+    repo_summary_payload,
+    repository_snapshot,
+    scan_repository_symbols,
+    stream_is_tty,
+    summarize_symbols,
+    symbol_change_payload,
+    symbol_search_hit_payload,
+    watch_changes,
+    write_symbol_export,
+)
 from repo_mcp.tools.builtin import register_builtin_tools
 from repo_mcp.tools.registry import ToolDispatchError, ToolRegistry
 
@@ -60,15 +111,172 @@ class _AdapterProfileBucket(TypedDict):
 def build_arg_parser() -> argparse.ArgumentParser:
     """Build argument parser for server startup configuration."""
     parser = argparse.ArgumentParser(prog="repo-mcp")
-    parser.add_argument("--repo-root", required=False, default=".")
-    parser.add_argument("--data-dir", required=False, default=None)
-    parser.add_argument("--max-file-bytes", type=int, required=False, default=None)
-    parser.add_argument("--max-open-lines", type=int, required=False, default=None)
-    parser.add_argument("--max-total-bytes-per-response", type=int, required=False, default=None)
-    parser.add_argument("--max-search-hits", type=int, required=False, default=None)
-    parser.add_argument("--max-references", type=int, required=False, default=None)
     parser.add_argument(
-        "--python-adapter-enabled", choices=("true", "false"), required=False, default=None
+        "--repo-root", metavar="PATH", default=".", help="Repository root to serve."
+    )
+    parser.add_argument(
+        "--data-dir", metavar="PATH", default=None, help="Data directory (default: .repo_mcp)."
+    )
+    parser.add_argument(
+        "--max-file-bytes", type=int, metavar="BYTES", default=None, help="Largest file read."
+    )
+    parser.add_argument(
+        "--max-open-lines",
+        type=int,
+        metavar="LINES",
+        default=None,
+        help="Most lines returned by repo.open_file.",
+    )
+    parser.add_argument(
+        "--max-total-bytes-per-response",
+        type=int,
+        metavar="BYTES",
+        default=None,
+        help="Largest tool response.",
+    )
+    parser.add_argument(
+        "--max-search-hits", type=int, metavar="N", default=None, help="Most search hits."
+    )
+    parser.add_argument(
+        "--max-references", type=int, metavar="N", default=None, help="Most references."
+    )
+    parser.add_argument(
+        "--python-adapter-enabled",
+        choices=("true", "false"),
+        default=None,
+        help="Outline Python with the AST adapter.",
+    )
+    parser.add_argument(
+        "--concurrency", type=int, metavar="N", default=None, help="Symbol scan workers."
+    )
+    parser.add_argument(
+        "--exclude",
+        action="append",
+        metavar="GLOB",
+        default=None,
+        help="Skip matching paths (repeatable).",
+    )
+    parser.add_argument(
+        "--include",
+        action="append",
+        metavar="GLOB",
+        default=None,
+        help="Scan only matching paths (repeatable).",
+    )
+    parser.add_argument(
+        "--respect-gitignore",
+        choices=("true", "false"),
+        default=None,
+        help="Skip paths ignored by .gitignore.",
+    )
+    parser.add_argument(
+        "--skip-tests", action="store_true", default=None, help="Leave out test files."
+    )
+    parser.add_argument(
+        "--watch", action="store_true", default=False, help="Re-export symbols on changes."
+    )
+    parser.add_argument(
+        "--watch-debounce-ms",
+        type=int,
+        metavar="MS",
+        default=None,
+        help="Quiet window that ends a burst of changes.",
+    )
+    parser.add_argument(
+        "--goos", choices=GO_OS_VALUES, default=None, help="Go target operating system."
+    )
+    parser.add_argument(
+        "--goarch", choices=GO_ARCH_VALUES, default=None, help="Go target architecture."
+    )
+    parser.add_argument(
+        "--strict", action="store_true", default=None, help="Fail exports on parse diagnostics."
+    )
+    parser.add_argument(
+        "--kinds",
+        metavar="KINDS",
+        default=None,
+        help=f"Comma-separated symbol kinds to emit, from: {', '.join(SYMBOL_KINDS)}.",
+    )
+    parser.add_argument(
+        "--max-file-size",
+        type=int,
+        metavar="BYTES",
+        default=None,
+        help="Skip larger files in symbol scans.",
+    )
+    parser.add_argument(
+        "--format", choices=OUTPUT_FORMATS, default=None, help="Default export format."
+    )
+    parser.add_argument(
+        "--public-only", action="store_true", default=None, help="Outline public symbols only."
+    )
+    parser.add_argument(
+        "--deprecated-only",
+        action="store_true",
+        default=None,
+        help="Outline deprecated symbols only.",
+    )
+    parser.add_argument(
+        "--graph-level", choices=GRAPH_LEVELS, default=None, help="Node granularity of dot."
+    )
+    parser.add_argument(
+        "--group-by-type",
+        action="store_true",
+        default=None,
+        help="Nest members under their type in exports.",
+    )
+    parser.add_argument(
+        "--min-complexity",
+        type=int,
+        metavar="N",
+        default=None,
+        help="Outline functions of at least this complexity.",
+    )
+    parser.add_argument(
+        "--db",
+        metavar="PATH",
+        default=None,
+        help="Database path for sqlite symbol exports.",
+    )
+    parser.add_argument(
+        "--redact", action="store_true", default=None, help="Redact secret-like symbol text."
+    )
+    progress = parser.add_mutually_exclusive_group()
+    progress.add_argument(
+        "--progress",
+        dest="progress",
+        action="store_const",
+        const="always",
+        default=None,
+        help="Always show scan progress on stderr.",
+    )
+    progress.add_argument(
+        "--quiet",
+        dest="progress",
+        action="store_const",
+        const="never",
+        help="Never show scan progress.",
+    )
+    parser.add_argument(
+        "--config", metavar="PATH", default=None, help="Config file instead of repo_mcp.toml."
+    )
+    commands = parser.add_subparsers(dest="command", metavar="COMMAND")
+    completion = commands.add_parser(
+        "completion",
+        help="Print a shell completion script.",
+        description="Print a shell completion script for repo-mcp to stdout.",
+    )
+    completion.add_argument("shell", choices=COMPLETION_SHELLS, help="Target shell.")
+    flags = commands.add_parser(
+        "flags",
+        help="List every command and flag.",
+        description="List every command and flag with its type, default, and help text.",
+    )
+    flags.add_argument("--json", action="store_true", default=False, help="Print JSON.")
+    commands.add_parser(
+        "schema",
+        help="Print the JSON Schema of symbol exports.",
+        description="Print the JSON Schema (draft 2020-12) of the json symbol export document.",
     )
     return parser
 
@@ -79,12 +287,18 @@ class StdioServer:
     def __init__(
         self,
         config: ServerConfig,
+        *,
+        progress_stream: TextIO | None = None,
     ) -> None:
         self._repo_root = config.repo_root
+        self._progress_stream = progress_stream
         self._limits = config.limits
         self._data_dir = config.data_dir
         self._config = config
         self._adapters: AdapterRegistry = build_adapter_registry(config)
+        self._redactor = (
+            Redactor(config.security.redact_patterns) if config.security.redact else None
+        )
         self._audit_logger = JsonlAuditLogger(path=self._data_dir / "audit.jsonl")
         self._index_manager = IndexManager(
             repo_root=self._repo_root,
@@ -105,6 +319,12 @@ class StdioServer:
             build_context_bundle=self._build_context_bundle,
             resolve_references=self._resolve_references,
             find_definition=self._find_definition,
+            export_symbols=self._export_symbols,
+            pack_symbols=self._pack_symbols,
+            query_symbols=self._query_symbols,
+            search_symbols=self._search_symbols,
+            summary=self._summary,
+            diff_symbols=self._diff_symbols,
             config=self._config,
             semantic_status=self._index_manager.semantic_status,
         )
@@ -133,6 +353,52 @@ class StdioServer:
             out_stream.write(f"{json.dumps(response, sort_keys=True)}\n")
             out_stream.flush()
 
+    def watch(
+        self,
+        out_stream: TextIO,
+        *,
+        poll_seconds: float = DEFAULT_WATCH_POLL_SECONDS,
+        should_stop: Callable[[], bool] = lambda: False,
+        sleep: Callable[[float], None] = time.sleep,
+    ) -> None:
+        """Export symbols, then re-export after every debounced burst of file changes.
+
+        Each export reuses the symbol cache, so only changed files are re-parsed
+        and deleted files are pruned from the cache and the artifact. One JSON
+        line per export is written to out_stream with the changed and removed
+        paths and the `repo.export_symbols` summary; a failed export writes an
+        `error` event and watching continues.
+        """
+        self._write_watch_event(out_stream, "initial", (), ())
+        batches = watch_changes(
+            lambda previous: repository_snapshot(self._repo_root, self._config.index, previous),
+            debounce_seconds=self._config.scan.watch_debounce_ms / 1000,
+            poll_seconds=poll_seconds,
+            should_stop=should_stop,
+            sleep=sleep,
+        )
+        for batch in batches:
+            self._write_watch_event(out_stream, "update", batch.changed, batch.removed)
+
+    def _write_watch_event(
+        self,
+        out_stream: TextIO,
+        event: str,
+        changed: tuple[str, ...],
+        removed: tuple[str, ...],
+    ) -> None:
+        payload: dict[str, object] = {
+            "event": event,
+            "changed": list(changed),
+            "removed": list(removed),
+        }
+        try:
+            payload.update(self._export_symbols({}))
+        except ToolDispatchError as error:
+            payload.update({"event": "error", "code": error.code, "message": error.message})
+        out_stream.write(f"{json.dumps(payload, sort_keys=True)}\n")
+        out_stream.flush()
+
     def handle_json_line(self, raw_line: str) -> dict[str, object] | None:
         """Handle a single JSON-line request. Returns None for notifications."""
         try:
@@ -342,7 +608,13 @@ class StdioServer:
         )
         self._audit_logger.append(event)
 
-    def _outline_path(self, path: str) -> dict[str, object]:
+    def _outline_path(
+        self,
+        path: str,
+        public_only: bool | None = None,
+        deprecated_only: bool | None = None,
+        min_complexity: int | None = None,
+    ) -> dict[str, object]:
         resolved = resolve_repo_path(repo_root=self._repo_root, candidate=path)
         enforce_file_access_policy(
             repo_root=self._repo_root,
@@ -358,24 +630,34 @@ class StdioServer:
         text = resolved.read_text(encoding="utf-8", errors="replace")
         adapter = self._adapters.select(relative_path)
         symbols = normalize_and_sort_symbols(adapter.outline(relative_path, text))
+        if self._redactor is not None:
+            symbols = redact_symbols(symbols, self._redactor)
+        (outlined,) = link_symbol_groups(
+            [FileSymbols(path=relative_path, language=adapter.name, symbols=tuple(symbols))],
+            self._allowed_kinds(),
+        )
+        symbols = list(outlined.symbols)
+        if public_only is None:
+            public_only = self._config.output.public_only
+        if public_only:
+            symbols = [symbol for symbol in symbols if symbol.visibility == "public"]
+        if deprecated_only is None:
+            deprecated_only = self._config.output.deprecated_only
+        if deprecated_only:
+            symbols = [symbol for symbol in symbols if symbol.deprecated is True]
+        if min_complexity is None:
+            min_complexity = self._config.output.min_complexity
+        if min_complexity is not None:
+            symbols = [
+                symbol
+                for symbol in symbols
+                if symbol.complexity is not None and symbol.complexity >= min_complexity
+            ]
         return {
             "path": relative_path,
             "language": adapter.name,
-            "symbols": [
-                {
-                    "kind": symbol.kind,
-                    "name": symbol.name,
-                    "signature": symbol.signature,
-                    "start_line": symbol.start_line,
-                    "end_line": symbol.end_line,
-                    "doc": symbol.doc,
-                    "parent_symbol": symbol.parent_symbol,
-                    "scope_kind": symbol.scope_kind,
-                    "is_conditional": symbol.is_conditional,
-                    "decl_context": symbol.decl_context,
-                }
-                for symbol in symbols
-            ],
+            "symbols": [outline_symbol_payload(symbol) for symbol in symbols],
+            "imports": imports_payload(adapter.imports(relative_path, text)),
         }
 
     def _list_files(self, arguments: dict[str, object]) -> dict[str, object]:
@@ -627,6 +909,8 @@ class StdioServer:
                 outline_symbols = normalize_and_sort_symbols(adapter.outline(path, text))
             except Exception:
                 continue
+            if self._redactor is not None:
+                outline_symbols = redact_symbols(outline_symbols, self._redactor)
             for outline_symbol in outline_symbols:
                 if not outline_symbol_matches(outline_symbol.name, symbol):
                     continue
@@ -651,6 +935,284 @@ class StdioServer:
             "total_candidates": total_candidates,
         }
 
+    def _export_symbols(self, arguments: dict[str, object]) -> dict[str, object]:
+        format_value = arguments.get("format")
+        export_format = (
+            format_value if isinstance(format_value, str) else self._config.output.format
+        )
+        concurrency_value = arguments.get("concurrency")
+        concurrency = (
+            concurrency_value
+            if isinstance(concurrency_value, int)
+            else self._config.scan.concurrency
+        )
+        force = arguments.get("force", False) is True
+        skip_tests = self._skip_tests(arguments)
+        graph_level_value = arguments.get("graph_level")
+        graph_level = (
+            graph_level_value
+            if isinstance(graph_level_value, str)
+            else self._config.output.graph_level
+        )
+        strict_value = arguments.get("strict")
+        strict = strict_value if isinstance(strict_value, bool) else self._config.scan.strict
+        group_by_type_value = arguments.get("group_by_type")
+        group_by_type = (
+            group_by_type_value
+            if isinstance(group_by_type_value, bool)
+            else self._config.output.group_by_type
+        )
+        destination = self._data_dir / "exports" / export_filename(export_format)
+        if export_format == "sqlite" and self._config.output.db_path is not None:
+            destination = self._config.output.db_path
+        scan_profile: dict[str, object] = {}
+        diagnostics: list[Diagnostic] = []
+        skipped_files: list[SkippedFile] = []
+        groups = self._scan_symbol_groups(
+            concurrency=concurrency,
+            reuse_cache=not force,
+            skip_tests=skip_tests,
+            profile=scan_profile,
+            diagnostics=diagnostics,
+            skipped=skipped_files,
+        )
+        try:
+            summary = write_symbol_export(
+                groups,
+                destination,
+                export_format,
+                graph_level=graph_level,
+                diagnostics=diagnostics,
+                skipped_files=skipped_files,
+                group_by_type=group_by_type,
+                kinds=self._allowed_kinds(),
+            )
+        except OSError as error:
+            raise ToolDispatchError(
+                code="EXPORT_WRITE_FAILED",
+                message=f"Failed to write symbol export: {error}",
+            ) from error
+        skipped_paths = {item.path for item in skipped_files}
+        parse_errors = [item for item in diagnostics if item.path not in skipped_paths]
+        if strict and parse_errors:
+            first = parse_errors[0]
+            location = first.path if first.line is None else f"{first.path}:{first.line}"
+            file_count = len({item.path for item in parse_errors})
+            raise ToolDispatchError(
+                code="PARSE_FAILED",
+                message=(
+                    f"Strict mode: parse errors in {file_count} file(s); "
+                    f"first at {location}: {first.message}"
+                ),
+            )
+        files_failed = scan_profile.get("files_failed", 0)
+        files_cached = scan_profile.get("cache_hits", 0)
+        return asdict(
+            replace(
+                summary,
+                files_failed=files_failed if isinstance(files_failed, int) else 0,
+                files_cached=files_cached if isinstance(files_cached, int) else 0,
+                diagnostics=tuple(diagnostics),
+            )
+        )
+
+    def _scan_symbol_groups(
+        self,
+        *,
+        concurrency: int | None,
+        reuse_cache: bool = True,
+        skip_tests: bool = False,
+        profile: dict[str, object] | None = None,
+        diagnostics: list[Diagnostic] | None = None,
+        skipped: list[SkippedFile] | None = None,
+    ) -> Iterator[FileSymbols]:
+        return scan_repository_symbols(
+            repo_root=self._repo_root,
+            index_config=self._config.index,
+            limits=self._limits,
+            adapters=self._adapters,
+            concurrency=concurrency,
+            cache_path=self._data_dir / SYMBOL_CACHE_RELATIVE_PATH,
+            reuse_cache=reuse_cache,
+            skip_tests=skip_tests,
+            go_target=resolve_go_target(self._config.scan.goos, self._config.scan.goarch),
+            kinds=self._config.scan.kinds,
+            max_file_size=self._config.scan.max_file_size,
+            profile=profile,
+            diagnostics=diagnostics,
+            skipped=skipped,
+            progress=self._scan_progress(),
+            redactor=self._redactor,
+        )
+
+    def _linked_symbol_groups(
+        self, arguments: dict[str, object], profile: dict[str, object] | None = None
+    ) -> list[FileSymbols]:
+        """Scan with the configured concurrency and run the export's post-scan linking."""
+        return link_symbol_groups(
+            self._scan_symbol_groups(
+                concurrency=self._config.scan.concurrency,
+                skip_tests=self._skip_tests(arguments),
+                profile=profile,
+            ),
+            self._allowed_kinds(),
+        )
+
+    def _allowed_kinds(self) -> frozenset[str] | None:
+        if self._config.scan.kinds is None:
+            return None
+        return kind_allowlist(self._config.scan.kinds)
+
+    def _scan_progress(self) -> ProgressReporter | None:
+        """Return a stderr progress reporter per `output.progress`, or None when disabled."""
+        stream = self._progress_stream if self._progress_stream is not None else sys.stderr
+        if not progress_enabled(self._config.output.progress, stream):
+            return None
+        return ProgressReporter(stream, tty=stream_is_tty(stream))
+
+    def _skip_tests(self, arguments: dict[str, object]) -> bool:
+        skip_tests = arguments.get("skip_tests")
+        if isinstance(skip_tests, bool):
+            return skip_tests
+        return self._config.scan.skip_tests
+
+    def _query_symbols(self, arguments: dict[str, object]) -> dict[str, object]:
+        filters: dict[str, str | bool | int] = {
+            name: value
+            for name in ("kind", "visibility", "returns", "package")
+            if isinstance(value := arguments.get(name), str)
+        }
+        deprecated = arguments.get("deprecated")
+        if isinstance(deprecated, bool):
+            filters["deprecated"] = deprecated
+        min_complexity = arguments.get("min_complexity")
+        if isinstance(min_complexity, int) and not isinstance(min_complexity, bool):
+            filters["min_complexity"] = min_complexity
+        format_value = arguments.get("format", "json")
+        query_format = format_value if isinstance(format_value, str) else "json"
+        groups = query_symbols(self._linked_symbol_groups(arguments), SymbolQuery(**filters))
+        result: dict[str, object] = {
+            "format": query_format,
+            "files_matched": len(groups),
+            "symbol_count": sum(len(group.symbols) for group in groups),
+        }
+        if query_format == "markdown":
+            result["text"] = render_markdown_overview(groups)
+        else:
+            result["files"] = [file_symbols_payload(group) for group in groups]
+        return result
+
+    def _search_symbols(self, arguments: dict[str, object]) -> dict[str, object]:
+        query_value = arguments.get("query")
+        query = query_value if isinstance(query_value, str) else ""
+        limit_value = arguments.get("limit")
+        limit = limit_value if isinstance(limit_value, int) else self._limits.max_search_hits
+        format_value = arguments.get("format", "json")
+        search_format = format_value if isinstance(format_value, str) else "json"
+        index = SymbolSearchIndex(self._linked_symbol_groups(arguments))
+        hits = index.search(query)
+        shown = hits[:limit]
+        result: dict[str, object] = {
+            "query": query,
+            "format": search_format,
+            "total_matches": len(hits),
+            "truncated": len(hits) > limit,
+        }
+        if search_format == "markdown":
+            result["text"] = render_search_markdown(query, shown)
+        else:
+            result["hits"] = [symbol_search_hit_payload(hit) for hit in shown]
+        return result
+
+    def _summary(self, arguments: dict[str, object]) -> dict[str, object]:
+        format_value = arguments.get("format", "json")
+        summary_format = format_value if isinstance(format_value, str) else "json"
+        scan_profile: dict[str, object] = {}
+        summary = summarize_symbols(self._linked_symbol_groups(arguments, scan_profile))
+        files_failed = scan_profile.get("files_failed", 0)
+        result: dict[str, object] = {
+            "format": summary_format,
+            "files_failed": files_failed if isinstance(files_failed, int) else 0,
+        }
+        if summary_format == "markdown":
+            result["text"] = render_summary_markdown(summary)
+        else:
+            result.update(repo_summary_payload(summary))
+        return result
+
+    def _pack_symbols(self, arguments: dict[str, object]) -> dict[str, object]:
+        max_tokens_value = arguments.get("max_tokens")
+        max_tokens = max_tokens_value if isinstance(max_tokens_value, int) else 1
+        chars_value = arguments.get("chars_per_token")
+        chars_per_token = chars_value if isinstance(chars_value, int) else DEFAULT_CHARS_PER_TOKEN
+        groups = self._linked_symbol_groups(arguments)
+        pack = pack_symbols(groups, max_tokens, char_ratio_estimator(chars_per_token))
+        return {
+            "text": pack.text,
+            "max_tokens": pack.max_tokens,
+            "estimated_tokens": pack.estimated_tokens,
+            "tokenizer": f"chars/{chars_per_token}",
+            "symbols_included": pack.symbols_included,
+            "symbols_total": pack.symbols_total,
+            "files": [asdict(item) for item in pack.files],
+        }
+
+    def _diff_symbols(self, arguments: dict[str, object]) -> dict[str, object]:
+        old_value = arguments.get("old")
+        old_path = old_value if isinstance(old_value, str) else ""
+        new_value = arguments.get("new")
+        old_groups = self._read_symbol_export("old", old_path)
+        if isinstance(new_value, str):
+            new_groups = self._read_symbol_export("new", new_value)
+        else:
+            new_groups = self._linked_symbol_groups(arguments)
+        fail_on_value = arguments.get("fail_on", "none")
+        fail_on = fail_on_value if isinstance(fail_on_value, str) else "none"
+        diff = diff_symbols(old_groups, new_groups)
+        return {
+            "old": old_path,
+            "new": new_value if isinstance(new_value, str) else None,
+            "added": [symbol_change_payload(entry) for entry in diff.added],
+            "removed": [symbol_change_payload(entry) for entry in diff.removed],
+            "changed": [symbol_change_payload(entry) for entry in diff.changed],
+            "breaking_count": diff.breaking_count,
+            "fail_on": fail_on,
+            "failed": diff_failed(diff, fail_on),
+        }
+
+    def _read_symbol_export(self, name: str, path: str) -> list[FileSymbols]:
+        """Read a diff input, allowing exports past `max_file_bytes` up to their own limit."""
+        resolved = resolve_repo_path(repo_root=self._repo_root, candidate=path)
+        if resolved.is_file() and resolved.stat().st_size > DIFF_INPUT_MAX_BYTES:
+            raise PolicyBlockedError(
+                reason="Symbol export exceeds the repo.diff_symbols input limit.",
+                hint=f"Diff exports of at most {DIFF_INPUT_MAX_BYTES} bytes.",
+            )
+        enforce_file_access_policy(
+            repo_root=self._repo_root,
+            resolved_path=resolved,
+            limits=replace(self._limits, max_file_bytes=DIFF_INPUT_MAX_BYTES),
+        )
+        if not resolved.exists() or not resolved.is_file():
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=f"repo.diff_symbols {name} is not a readable file: {path}",
+            )
+        try:
+            groups = parse_symbol_export(resolved.read_text(encoding="utf-8", errors="replace"))
+        except ValueError as error:
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=f"repo.diff_symbols {name} is not a symbol export: {error}.",
+            ) from error
+        if self._redactor is None:
+            return groups
+        redactor = self._redactor
+        return [
+            replace(group, symbols=tuple(redact_symbols(group.symbols, redactor)))
+            for group in groups
+        ]
+
     def _reference_source_files(
         self,
         path_scope: str | None,
@@ -1091,8 +1653,12 @@ def create_server(
     limits: object | None = None,
     data_dir: str | None = None,
     cli_overrides: CliOverrides | None = None,
+    config_path: str | None = None,
 ) -> StdioServer:
-    """Create a configured STDIO server instance."""
+    """Create a configured STDIO server instance.
+
+    config_path replaces `<repo_root>/repo_mcp.toml` and must exist.
+    """
     legacy_max_file_bytes: int | None = None
     legacy_max_open_lines: int | None = None
     legacy_max_total_bytes: int | None = None
@@ -1142,9 +1708,33 @@ def create_server(
                 else overrides.max_references
             ),
             python_enabled=cli_overrides.python_enabled,
+            concurrency=cli_overrides.concurrency,
+            exclude_globs=cli_overrides.exclude_globs,
+            include_globs=cli_overrides.include_globs,
+            respect_gitignore=cli_overrides.respect_gitignore,
+            skip_tests=cli_overrides.skip_tests,
+            watch_debounce_ms=cli_overrides.watch_debounce_ms,
+            goos=cli_overrides.goos,
+            goarch=cli_overrides.goarch,
+            strict=cli_overrides.strict,
+            kinds=cli_overrides.kinds,
+            max_file_size=cli_overrides.max_file_size,
+            output_format=cli_overrides.output_format,
+            public_only=cli_overrides.public_only,
+            deprecated_only=cli_overrides.deprecated_only,
+            graph_level=cli_overrides.graph_level,
+            progress=cli_overrides.progress,
+            group_by_type=cli_overrides.group_by_type,
+            min_complexity=cli_overrides.min_complexity,
+            db_path=cli_overrides.db_path,
+            redact=cli_overrides.redact,
         )
 
-    config = load_effective_config(repo_root=Path(repo_root).resolve(), overrides=overrides)
+    config = load_effective_config(
+        repo_root=Path(repo_root).resolve(),
+        overrides=overrides,
+        config_path=Path(config_path).resolve() if config_path is not None else None,
+    )
     return StdioServer(config=config)
 
 
@@ -1152,11 +1742,23 @@ def main(argv: list[str] | None = None) -> int:
     """Entrypoint for the repo interrogator server process."""
     parser = build_arg_parser()
     args = parser.parse_args(argv)
+    if args.command == "completion":
+        sys.stdout.write(render_completion(parser, args.shell))
+        return 0
+    if args.command == "flags":
+        sys.stdout.write(render_flags(parser, as_json=args.json))
+        return 0
+    if args.command == "schema":
+        sys.stdout.write(render_export_schema())
+        return 0
     python_enabled: bool | None = None
     if args.python_adapter_enabled == "true":
         python_enabled = True
     if args.python_adapter_enabled == "false":
         python_enabled = False
+    respect_gitignore: bool | None = None
+    if args.respect_gitignore is not None:
+        respect_gitignore = args.respect_gitignore == "true"
     overrides = CliOverrides(
         data_dir=Path(args.data_dir).resolve() if args.data_dir is not None else None,
         max_file_bytes=args.max_file_bytes,
@@ -1165,8 +1767,43 @@ def main(argv: list[str] | None = None) -> int:
         max_search_hits=args.max_search_hits,
         max_references=args.max_references,
         python_enabled=python_enabled,
+        concurrency=args.concurrency,
+        exclude_globs=tuple(args.exclude or ()),
+        include_globs=tuple(args.include or ()),
+        respect_gitignore=respect_gitignore,
+        skip_tests=args.skip_tests,
+        watch_debounce_ms=args.watch_debounce_ms,
+        goos=args.goos,
+        goarch=args.goarch,
+        strict=args.strict,
+        kinds=(
+            tuple(part.strip() for part in args.kinds.split(",") if part.strip())
+            if args.kinds is not None
+            else None
+        ),
+        max_file_size=args.max_file_size,
+        output_format=args.format,
+        public_only=args.public_only,
+        deprecated_only=args.deprecated_only,
+        graph_level=args.graph_level,
+        progress=args.progress,
+        group_by_type=args.group_by_type,
+        min_complexity=args.min_complexity,
+        db_path=Path(args.db) if args.db is not None else None,
+        redact=args.redact,
     )
-    server = create_server(repo_root=args.repo_root, cli_overrides=overrides)
+    try:
+        server = create_server(
+            repo_root=args.repo_root, cli_overrides=overrides, config_path=args.config
+        )
+    except ValueError as error:
+        parser.error(f"invalid configuration: {error}")
+    if args.watch:
+        try:
+            server.watch(out_stream=sys.stdout)
+        except KeyboardInterrupt:
+            pass
+        return 0
     cprofile_output_raw = os.getenv("REPO_MCP_SERVER_CPROFILE_OUTPUT", "").strip()
     if cprofile_output_raw:
         profiler = cProfile.Profile()
@@ -0,0 +1,179 @@
+"""Repository-wide symbol scanning and export."""
+
+from .cache import SYMBOL_CACHE_RELATIVE_PATH, load_symbol_cache, write_symbol_cache
+from .diff import (
+    DIFF_FAIL_ON,
+    DIFF_INPUT_MAX_BYTES,
+    diff_failed,
+    diff_symbols,
+    parse_symbol_export,
+    symbol_change_payload,
+)
+from .dot import DOT_GRAPH_NAME, file_package, render_dot
+from .examples import attach_examples, example_target
+from .export import (
+    EXPORT_FORMATS,
+    EXPORT_VERSION,
+    JsonlSymbolWriter,
+    export_filename,
+    file_symbols_payload,
+    imports_payload,
+    write_symbol_export,
+)
+from .grouping import TYPE_KINDS, group_symbols_by_type, symbol_node_payload
+from .lint import LINT_LEVELS, LINT_RULES, lint_symbols
+from .markdown import render_markdown_overview
+from .models import (
+    CachedFileSymbols,
+    Diagnostic,
+    ExportSummary,
+    FileLineCount,
+    FileSymbols,
+    LanguageSummary,
+    LintFinding,
+    LintRule,
+    PackageSummary,
+    PackedFile,
+    PartialType,
+    PartialTypeMember,
+    RepoSummary,
+    SkippedFile,
+    SymbolChange,
+    SymbolDiff,
+    SymbolNode,
+    SymbolPack,
+    SymbolQuery,
+    SymbolSearchHit,
+    WatchBatch,
+)
+from .pack import DEFAULT_CHARS_PER_TOKEN, TokenEstimator, char_ratio_estimator, pack_symbols
+from .packages import link_package_symbols
+from .partial import merge_partial_types, partial_type_payload
+from .pipeline import link_symbol_groups
+from .progress import (
+    PROGRESS_LOG_INTERVAL_SECONDS,
+    PROGRESS_TTY_INTERVAL_SECONDS,
+    ProgressReporter,
+    progress_enabled,
+    stream_is_tty,
+)
+from .query import (
+    QUERY_FORMATS,
+    kind_allowed,
+    kind_allowlist,
+    query_symbols,
+    select_kinds,
+    symbol_matches,
+)
+from .redaction import redact_symbols
+from .sarif import SARIF_VERSION, render_sarif
+from .scan import resolve_scan_concurrency, scan_repository_symbols
+from .schema import JSON_SCHEMA_DIALECT, export_schema, render_export_schema
+from .search import (
+    SEARCH_FORMATS,
+    SymbolSearchIndex,
+    render_search_markdown,
+    search_terms,
+    symbol_search_hit_payload,
+)
+from .sqlite import SQLITE_SCHEMA_VERSION, write_sqlite_export
+from .summary import (
+    SUMMARY_FORMATS,
+    SUMMARY_LARGEST_FILES,
+    render_summary_markdown,
+    repo_summary_payload,
+    summarize_symbols,
+)
+from .typerefs import resolve_type_references
+from .watch import DEFAULT_WATCH_POLL_SECONDS, repository_snapshot, watch_changes
+
+__all__ = [
+    "DEFAULT_CHARS_PER_TOKEN",
+    "DEFAULT_WATCH_POLL_SECONDS",
+    "DIFF_FAIL_ON",
+    "DIFF_INPUT_MAX_BYTES",
+    "DOT_GRAPH_NAME",
+    "EXPORT_FORMATS",
+    "EXPORT_VERSION",
+    "JSON_SCHEMA_DIALECT",
+    "LINT_LEVELS",
+    "LINT_RULES",
+    "PROGRESS_LOG_INTERVAL_SECONDS",
+    "PROGRESS_TTY_INTERVAL_SECONDS",
+    "QUERY_FORMATS",
+    "SARIF_VERSION",
+    "SEARCH_FORMATS",
+    "SQLITE_SCHEMA_VERSION",
+    "SUMMARY_FORMATS",
+    "SUMMARY_LARGEST_FILES",
+    "SYMBOL_CACHE_RELATIVE_PATH",
+    "CachedFileSymbols",
+    "Diagnostic",
+    "ExportSummary",
+    "FileLineCount",
+    "FileSymbols",
+    "JsonlSymbolWriter",
+    "LanguageSummary",
+    "LintFinding",
+    "LintRule",
+    "PackageSummary",
+    "PackedFile",
+    "PartialType",
+    "PartialTypeMember",
+    "ProgressReporter",
+    "RepoSummary",
+    "SkippedFile",
+    "SymbolChange",
+    "SymbolDiff",
+    "SymbolPack",
+    "SymbolQuery",
+    "SymbolSearchHit",
+    "SymbolSearchIndex",
+    "TokenEstimator",
+    "WatchBatch",
+    "attach_examples",
+    "char_ratio_estimator",
+    "diff_failed",
+    "diff_symbols",
+    "example_target",
+    "export_filename",
+    "export_schema",
+    "file_package",
+    "file_symbols_payload",
+    "imports_payload",
+    "kind_allowed",
+    "kind_allowlist",
+    "link_package_symbols",
+    "link_symbol_groups",
+    "lint_symbols",
+    "load_symbol_cache",
+    "merge_partial_types",
+    "pack_symbols",
+    "parse_symbol_export",
+    "partial_type_payload",
+    "progress_enabled",
+    "query_symbols",
+    "redact_symbols",
+    "render_dot",
+    "render_export_schema",
+    "render_markdown_overview",
+    "render_sarif",
+    "render_search_markdown",
+    "render_summary_markdown",
+    "repo_summary_payload",
+    "repository_snapshot",
+    "resolve_scan_concurrency",
+    "resolve_type_references",
+    "scan_repository_symbols",
+    "search_terms",
+    "select_kinds",
+    "stream_is_tty",
+    "summarize_symbols",
+    "symbol_change_payload",
+    "symbol_matches",
+    "symbol_search_hit_payload",
+    "watch_changes",
+    "write_sqlite_export",
+    "write_symbol_cache",
+    "write_symbol_export",
+]
@@ -0,0 +1,123 @@
+"""Persistent content-hash cache for repository symbol scans."""
+
+from __future__ import annotations
+
+import json
+from dataclasses import asdict
+from pathlib import Path
+
+from repo_mcp.adapters.base import (
+    FileImport,
+    ParseDiagnostic,
+    outline_symbol_from_payload,
+    outline_symbol_payload,
+)
+from repo_mcp.index.models import FileRecord
+from repo_mcp.symbols.export import imports_payload
+from repo_mcp.symbols.models import CachedFileSymbols, FileSymbols
+
+SYMBOL_CACHE_VERSION = 24
+SYMBOL_CACHE_RELATIVE_PATH = Path("cache") / "symbols.json"
+
+
+def load_symbol_cache(
+    path: Path, *, redaction: str | None = None
+) -> dict[str, CachedFileSymbols]:
+    """Load cached symbol groups keyed by path.
+
+    A missing, unreadable, malformed, or version-mismatched cache yields an
+    empty mapping so the next scan simply re-parses every file. So does a
+    cache written under a different redaction fingerprint (None when
+    redaction is off), so redacted and unredacted symbols never mix.
+    """
+    try:
+        payload = json.loads(path.read_text(encoding="utf-8"))
+    except (OSError, ValueError):
+        return {}
+    if not isinstance(payload, dict) or payload.get("cache_version") != SYMBOL_CACHE_VERSION:
+        return {}
+    if payload.get("redaction") != redaction:
+        return {}
+    raw_files = payload.get("files")
+    if not isinstance(raw_files, list):
+        return {}
+    entries: dict[str, CachedFileSymbols] = {}
+    for item in raw_files:
+        try:
+            entry = _entry_from_payload(item)
+        except (KeyError, TypeError, ValueError):
+            continue
+        entries[entry.record.path] = entry
+    return entries
+
+
+def write_symbol_cache(
+    path: Path, entries: list[CachedFileSymbols], *, redaction: str | None = None
+) -> None:
+    """Atomically replace the cache with entries, sorted by path.
+
+    redaction records the fingerprint of the redactor the entries went through.
+    """
+    path.parent.mkdir(parents=True, exist_ok=True)
+    payload = {
+        "cache_version": SYMBOL_CACHE_VERSION,
+        "redaction": redaction,
+        "files": [
+            _entry_payload(entry) for entry in sorted(entries, key=lambda item: item.record.path)
+        ],
+    }
+    tmp = path.with_suffix(path.suffix + ".tmp")
+    with tmp.open("w", encoding="utf-8") as handle:
+        json.dump(payload, handle, sort_keys=True)
+        handle.write("\n")
+    tmp.replace(path)
+
+
+def _entry_payload(entry: CachedFileSymbols) -> dict[str, object]:
+    return {
+        "record": asdict(entry.record),
+        "language": entry.group.language,
+        "symbols": [outline_symbol_payload(symbol) for symbol in entry.group.symbols],
+        "imports": imports_payload(entry.group.imports),
+        "line_count": entry.group.line_count,
+        "diagnostics": [asdict(item) for item in entry.group.diagnostics],
+        "kinds": list(entry.kinds) if entry.kinds is not None else None,
+    }
+
+
+def _entry_from_payload(item: object) -> CachedFileSymbols:
+    if not isinstance(item, dict):
+        raise TypeError("cache entry must be an object")
+    raw_record = item["record"]
+    if not isinstance(raw_record, dict):
+        raise TypeError("cache record must be an object")
+    record = FileRecord(
+        path=str(raw_record["path"]),
+        size=int(raw_record["size"]),
+        mtime_ns=int(raw_record["mtime_ns"]),
+        content_hash=str(raw_record["content_hash"]),
+    )
+    raw_symbols = item["symbols"]
+    if not isinstance(raw_symbols, list):
+        raise TypeError("cache symbols must be a list")
+    symbols = tuple(outline_symbol_from_payload(symbol) for symbol in raw_symbols)
+    raw_imports = item.get("imports")
+    imports = None
+    if raw_imports is not None:
+        if not isinstance(raw_imports, list):
+            raise TypeError("cache imports must be a list")
+        imports = tuple(FileImport(**entry) for entry in raw_imports)
+    raw_line_count = item.get("line_count")
+    group = FileSymbols(
+        path=record.path,
+        language=str(item["language"]),
+        symbols=symbols,
+        imports=imports,
+        line_count=int(raw_line_count) if raw_line_count is not None else None,
+        diagnostics=tuple(ParseDiagnostic(**entry) for entry in item.get("diagnostics", [])),
+    )
+    raw_kinds = item.get("kinds")
+    if raw_kinds is not None and not isinstance(raw_kinds, list):
+        raise TypeError("cache kinds must be a list")
+    kinds = tuple(str(kind) for kind in raw_kinds) if raw_kinds is not None else None
+    return CachedFileSymbols(record=record, group=group, kinds=kinds)
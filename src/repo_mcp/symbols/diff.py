@@ -0,0 +1,166 @@
+"""Deterministic API diff between two symbol exports."""
+
+from __future__ import annotations
+
+import json
+from collections.abc import Iterable
+from dataclasses import replace
+
+from repo_mcp.adapters.base import OutlineSymbol, outline_symbol_from_payload
+from repo_mcp.symbols.export import EXPORT_VERSION
+from repo_mcp.symbols.models import FileSymbols, SymbolChange, SymbolDiff
+
+DIFF_FAIL_ON = ("none", "breaking", "any")
+DIFF_INPUT_MAX_BYTES = 256 * 1024 * 1024
+_COMPARED_FIELDS = ("signature", "canonical_signature", "returns", "visibility")
+
+_SymbolKey = tuple[str, str, int]
+
+
+def parse_symbol_export(text: str) -> list[FileSymbols]:
+    """Parse a `repo.export_symbols` JSON document or JSON Lines artifact.
+
+    Raises ValueError when the text is neither a version-matched JSON export
+    nor one file group object per line.
+    """
+    stripped = text.strip()
+    if not stripped:
+        return []
+    try:
+        document = json.loads(stripped)
+    except ValueError:
+        document = None
+    if isinstance(document, dict) and "export_version" in document:
+        if document["export_version"] != EXPORT_VERSION:
+            raise ValueError(f"unsupported export_version {document['export_version']!r}")
+        raw_groups = document.get("files")
+        if not isinstance(raw_groups, list):
+            raise ValueError("export document files must be a list")
+    else:
+        try:
+            raw_groups = [json.loads(line) for line in stripped.splitlines() if line.strip()]
+        except ValueError as error:
+            raise ValueError("not a JSON symbol export or JSON Lines artifact") from error
+    try:
+        return [_group_from_payload(item) for item in raw_groups]
+    except (KeyError, TypeError) as error:
+        raise ValueError("malformed file symbol group") from error
+
+
+def diff_symbols(old: Iterable[FileSymbols], new: Iterable[FileSymbols]) -> SymbolDiff:
+    """Match symbols by kind and qualified name and classify the differences.
+
+    The file declaring a symbol is not part of the match, so moving a
+    declaration to another file of its package is not a change; entries report
+    the new path. Repeated declarations of one name (for example several Rust
+    `impl` blocks) are paired in path then outline order. A symbol is changed
+    when its signature, parsed return types, or visibility differ; line moves
+    and doc edits are ignored.
+    When both sides carry a `canonical_signature`, it replaces the written
+    signature and return types, so cosmetic rewrites are not changes.
+    Removals and signature or return type changes of non-private symbols, and
+    visibility narrowing from public to private, are flagged as breaking.
+    """
+    old_symbols = _keyed_symbols(old)
+    new_symbols = _keyed_symbols(new)
+    added: list[SymbolChange] = []
+    removed: list[SymbolChange] = []
+    changed: list[SymbolChange] = []
+    for key in sorted(old_symbols.keys() | new_symbols.keys()):
+        path, symbol = new_symbols[key] if key in new_symbols else old_symbols[key]
+        before = old_symbols[key][1] if key in old_symbols else None
+        after = new_symbols[key][1] if key in new_symbols else None
+        entry = SymbolChange(
+            path=path, kind=symbol.kind, name=symbol.name, before=before, after=after
+        )
+        if before is None:
+            added.append(entry)
+        elif after is None:
+            removed.append(replace(entry, breaking=before.visibility != "private"))
+        elif _differs(before, after):
+            changed.append(replace(entry, breaking=_is_breaking(before, after)))
+    return SymbolDiff(
+        added=_sorted_changes(added),
+        removed=_sorted_changes(removed),
+        changed=_sorted_changes(changed),
+    )
+
+
+def diff_failed(diff: SymbolDiff, fail_on: str) -> bool:
+    """Return True when diff violates the fail_on policy (`none`, `breaking`, or `any`)."""
+    if fail_on == "any":
+        return bool(diff.added or diff.removed or diff.changed)
+    if fail_on == "breaking":
+        return diff.breaking_count > 0
+    return False
+
+
+def symbol_change_payload(change: SymbolChange) -> dict[str, object]:
+    """Return a JSON-ready payload for one diff entry with before/after fields."""
+    return {
+        "path": change.path,
+        "kind": change.kind,
+        "name": change.name,
+        "breaking": change.breaking,
+        "before": _compared_payload(change.before),
+        "after": _compared_payload(change.after),
+    }
+
+
+def _keyed_symbols(
+    groups: Iterable[FileSymbols],
+) -> dict[_SymbolKey, tuple[str, OutlineSymbol]]:
+    keyed: dict[_SymbolKey, tuple[str, OutlineSymbol]] = {}
+    seen: dict[tuple[str, str], int] = {}
+    for group in sorted(groups, key=lambda item: item.path):
+        for symbol in group.symbols:
+            qualified_name = symbol.qualified_name or symbol.name
+            occurrence = seen.get((qualified_name, symbol.kind), 0)
+            seen[(qualified_name, symbol.kind)] = occurrence + 1
+            keyed[(qualified_name, symbol.kind, occurrence)] = (group.path, symbol)
+    return keyed
+
+
+def _sorted_changes(changes: list[SymbolChange]) -> tuple[SymbolChange, ...]:
+    return tuple(sorted(changes, key=lambda item: (item.path, item.name, item.kind)))
+
+
+def _differs(before: OutlineSymbol, after: OutlineSymbol) -> bool:
+    return _signature_differs(before, after) or before.visibility != after.visibility
+
+
+def _is_breaking(before: OutlineSymbol, after: OutlineSymbol) -> bool:
+    if before.visibility == "public" and after.visibility == "private":
+        return True
+    if before.visibility == "private":
+        return False
+    return _signature_differs(before, after)
+
+
+def _signature_differs(before: OutlineSymbol, after: OutlineSymbol) -> bool:
+    if before.canonical_signature is not None and after.canonical_signature is not None:
+        return before.canonical_signature != after.canonical_signature
+    return before.signature != after.signature or before.returns != after.returns
+
+
+def _compared_payload(symbol: OutlineSymbol | None) -> dict[str, object] | None:
+    if symbol is None:
+        return None
+    payload: dict[str, object] = {}
+    for name in _COMPARED_FIELDS:
+        value = getattr(symbol, name)
+        payload[name] = list(value) if isinstance(value, tuple) else value
+    return payload
+
+
+def _group_from_payload(item: object) -> FileSymbols:
+    if not isinstance(item, dict):
+        raise TypeError("file symbol group must be an object")
+    raw_symbols = item["symbols"]
+    if not isinstance(raw_symbols, list):
+        raise TypeError("file symbol group symbols must be a list")
+    return FileSymbols(
+        path=str(item["path"]),
+        language=str(item["language"]),
+        symbols=tuple(outline_symbol_from_payload(symbol) for symbol in raw_symbols),
+    )
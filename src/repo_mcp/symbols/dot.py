@@ -0,0 +1,186 @@
+"""Deterministic Graphviz DOT rendering of package, file, and call relationships."""
+
+from __future__ import annotations
+
+import posixpath
+from collections.abc import Iterable
+from pathlib import PurePosixPath
+
+from repo_mcp.adapters.base import FileImport, OutlineSymbol
+from repo_mcp.config import GRAPH_LEVELS
+from repo_mcp.symbols.models import FileSymbols
+
+DOT_GRAPH_NAME = "symbols"
+_CALLABLE_KINDS = frozenset({"async_function", "async_method", "constructor", "function", "method"})
+_INDEX_SUFFIX = "/index"
+
+_Edge = tuple[str, str, str]
+
+
+def render_dot(groups: Iterable[FileSymbols], graph_level: str) -> str:
+    """Render file symbol groups as a Graphviz `digraph`.
+
+    graph_level `package` draws one node per package, `file` one per file,
+    and `symbol` one per function, method, or constructor. Import edges are
+    drawn at the package and file levels for imports that resolve to a
+    scanned file; call edges at every level for calls that resolve to a
+    scanned symbol. Edges within one node are dropped. Nodes and edges are
+    sorted, so the same symbols always render the same graph.
+    """
+    if graph_level not in GRAPH_LEVELS:
+        raise ValueError(f"Unsupported graph level: {graph_level}")
+    materialized = [group for group in groups if group.symbols]
+    nodes: dict[str, str] = {}
+    node_of_file: dict[str, str] = {}
+    for group in materialized:
+        if graph_level == "package":
+            node = file_package(group)
+            nodes[node] = node
+        elif graph_level == "file":
+            node = group.path
+            nodes[node] = group.path
+        else:
+            node = group.path
+            for symbol in group.symbols:
+                if symbol.kind in _CALLABLE_KINDS:
+                    nodes[_symbol_node(symbol)] = symbol.qualified_name or symbol.name
+        node_of_file[group.path] = node
+
+    edges: set[_Edge] = set()
+    if graph_level != "symbol":
+        addresses, directories = _file_addresses(materialized)
+        for group in materialized:
+            for record in group.imports or ():
+                for target_path in _import_targets(group, record, addresses, directories):
+                    edges.add((node_of_file[group.path], node_of_file[target_path], "imports"))
+
+    symbols_by_name: dict[str, list[tuple[str, OutlineSymbol]]] = {}
+    for group in materialized:
+        for symbol in group.symbols:
+            symbols_by_name.setdefault(symbol.name, []).append((group.path, symbol))
+    for group in materialized:
+        for symbol in group.symbols:
+            for callee_name in symbol.calls or ():
+                for callee_path, callee in symbols_by_name.get(callee_name, ()):
+                    if graph_level == "symbol":
+                        source, target = _symbol_node(symbol), _symbol_node(callee)
+                    else:
+                        source, target = node_of_file[group.path], node_of_file[callee_path]
+                    if source in nodes and target in nodes:
+                        edges.add((source, target, "calls"))
+
+    lines = [
+        f"digraph {_quote(DOT_GRAPH_NAME)} {{",
+        '  graph [rankdir="LR"];',
+        '  node [shape="box"];',
+    ]
+    for node in sorted(nodes):
+        label = _short_label(nodes[node], graph_level)
+        lines.append(f"  {_quote(node)} [label={_quote(label)}, tooltip={_quote(nodes[node])}];")
+    for source, target, relation in sorted(edges):
+        if source == target:
+            continue
+        style = "solid" if relation == "imports" else "dashed"
+        lines.append(
+            f"  {_quote(source)} -> {_quote(target)} "
+            f"[style={_quote(style)}, tooltip={_quote(relation)}];"
+        )
+    lines.append("}")
+    return "\n".join(lines) + "\n"
+
+
+def file_package(group: FileSymbols) -> str:
+    """Return the package of the file's first symbol that has one, else its directory."""
+    for symbol in group.symbols:
+        if symbol.package is not None:
+            return symbol.package
+    parent = PurePosixPath(group.path).parent.as_posix()
+    return parent
+
+
+def _symbol_node(symbol: OutlineSymbol) -> str:
+    return symbol.qualified_name or symbol.name
+
+
+def _short_label(name: str, graph_level: str) -> str:
+    if graph_level == "file":
+        return PurePosixPath(name).name
+    separator = "/" if "/" in name and graph_level == "package" else "."
+    return name.rsplit(separator, 1)[-1]
+
+
+def _file_addresses(
+    groups: list[FileSymbols],
+) -> tuple[dict[str, list[str]], dict[str, list[str]]]:
+    """Map the names an import may use for a file to files, and directories to their files.
+
+    A file answers to its path, its module path (without extension and
+    trailing `/index`), and the packages of its symbols.
+    """
+    addresses: dict[str, list[str]] = {}
+    directories: dict[str, list[str]] = {}
+    for group in groups:
+        path = PurePosixPath(group.path)
+        module = path.with_suffix("").as_posix().removesuffix(_INDEX_SUFFIX)
+        names = {module, group.path}
+        names.update(symbol.package for symbol in group.symbols if symbol.package is not None)
+        for name in names:
+            addresses.setdefault(name, []).append(group.path)
+        directory = path.parent.as_posix()
+        if directory != ".":
+            directories.setdefault(directory, []).append(group.path)
+    return addresses, directories
+
+
+def _import_targets(
+    group: FileSymbols,
+    record: FileImport,
+    addresses: dict[str, list[str]],
+    directories: dict[str, list[str]],
+) -> list[str]:
+    """Return scanned files an import refers to.
+
+    Exact matches against file addresses win; otherwise an import path that is
+    a directory or ends with `/<directory>` (Go module import paths) targets
+    every file in that directory.
+    """
+    targets: set[str] = set()
+    for candidate in _import_candidates(group, record):
+        targets.update(addresses.get(candidate, ()))
+    if not targets and not record.path.startswith("."):
+        for directory, paths in directories.items():
+            if record.path == directory or record.path.endswith(f"/{directory}"):
+                targets.update(paths)
+    targets.discard(group.path)
+    return sorted(targets)
+
+
+def _import_candidates(group: FileSymbols, record: FileImport) -> list[str]:
+    written = record.path
+    if written.startswith(("./", "../")):
+        directory = PurePosixPath(group.path).parent.as_posix()
+        joined = posixpath.normpath(posixpath.join(directory, written))
+        stem = PurePosixPath(joined).with_suffix("").as_posix()
+        return [joined, stem.removesuffix(_INDEX_SUFFIX)]
+    if written.startswith("."):
+        written = _resolve_relative_module(group, written)
+    candidates = [written]
+    if record.name is not None:
+        candidates.append(f"{written}.{record.name}" if written else record.name)
+    return candidates
+
+
+def _resolve_relative_module(group: FileSymbols, written: str) -> str:
+    """Resolve a Python relative import against the importing module's package."""
+    level = len(written) - len(written.lstrip("."))
+    remainder = written[level:]
+    module = file_package(group).split(".")
+    is_package = PurePosixPath(group.path).stem == "__init__"
+    keep = len(module) - level + (1 if is_package else 0)
+    base = module[: max(0, keep)]
+    return ".".join([*base, remainder] if remainder else base)
+
+
+def _quote(text: str) -> str:
+    escaped = text.replace("\\", "\\\\").replace('"', '\\"').replace("\n", "\\n")
+    return f'"{escaped}"'
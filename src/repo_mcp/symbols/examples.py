@@ -0,0 +1,87 @@
+"""Linking of Go example functions to the symbols they document."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+from dataclasses import replace
+from pathlib import PurePosixPath
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.symbols.models import FileSymbols
+
+_EXAMPLE_PREFIX = "Example"
+_EXTERNAL_TEST_SUFFIX = "_test"
+
+
+def example_target(name: str) -> str | None:
+    """Return the package-local name an example function documents, per `go doc`.
+
+    `ExampleF` documents `F` and `ExampleT_M` documents method `M` of `T`; an
+    optional `_suffix` starting with a lowercase letter distinguishes several
+    examples of one symbol. `Example` and `Example_suffix` document the
+    package and return None.
+    """
+    local_name = name.rsplit(".", 1)[-1]
+    if not local_name.startswith(_EXAMPLE_PREFIX):
+        return None
+    parts = local_name[len(_EXAMPLE_PREFIX) :].split("_")
+    if len(parts) > 1 and parts[-1][:1].islower():
+        parts.pop()
+    if not parts[0] or len(parts) > 2 or not all(part[:1].isupper() for part in parts):
+        return None
+    return ".".join(parts)
+
+
+def attach_examples(groups: Iterable[FileSymbols]) -> list[FileSymbols]:
+    """Append each example function's snippet to the `examples` of its target.
+
+    Examples link to symbols declared in the same directory and package; an
+    example in the external test package `<pkg>_test` counts as `<pkg>`.
+    Snippets are appended in path then line order after the target's own
+    doc comment examples, skipping duplicates. Groups are returned in input
+    order.
+    """
+    materialized = list(groups)
+    snippets: dict[tuple[str, str, str], list[str]] = {}
+    for group in sorted(materialized, key=lambda item: item.path):
+        directory = PurePosixPath(group.path).parent.as_posix()
+        for symbol in sorted(group.symbols, key=lambda item: item.start_line):
+            target = example_target(symbol.name) if symbol.role == "example" else None
+            if target is None or symbol.package is None or not symbol.examples:
+                continue
+            package = symbol.package.removesuffix(_EXTERNAL_TEST_SUFFIX)
+            snippets.setdefault((directory, package, target), []).extend(symbol.examples)
+    if not snippets:
+        return materialized
+    linked: list[FileSymbols] = []
+    for group in materialized:
+        directory = PurePosixPath(group.path).parent.as_posix()
+        linked.append(
+            replace(
+                group,
+                symbols=tuple(
+                    _with_examples(symbol, snippets.get(key, ()))
+                    if (key := _symbol_key(directory, symbol)) is not None
+                    else symbol
+                    for symbol in group.symbols
+                ),
+            )
+        )
+    return linked
+
+
+def _symbol_key(directory: str, symbol: OutlineSymbol) -> tuple[str, str, str] | None:
+    package = symbol.package
+    if package is None or symbol.role == "example" or not symbol.name.startswith(f"{package}."):
+        return None
+    return (directory, package, symbol.name[len(package) + 1 :])
+
+
+def _with_examples(symbol: OutlineSymbol, snippets: Iterable[str]) -> OutlineSymbol:
+    examples = list(symbol.examples or ())
+    for snippet in snippets:
+        if snippet not in examples:
+            examples.append(snippet)
+    if len(examples) == len(symbol.examples or ()):
+        return symbol
+    return replace(symbol, examples=tuple(examples))
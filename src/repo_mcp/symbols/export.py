@@ -0,0 +1,197 @@
+"""Symbol export writers for JSON, streaming JSON Lines, Markdown, SARIF, DOT, and SQLite."""
+
+from __future__ import annotations
+
+import json
+from collections.abc import Iterable, Sequence
+from dataclasses import asdict
+from pathlib import Path
+from typing import TextIO
+
+from repo_mcp.adapters.base import FileImport, file_import_payload, outline_symbol_payload
+from repo_mcp.config import OUTPUT_FORMATS
+from repo_mcp.symbols.dot import render_dot
+from repo_mcp.symbols.grouping import group_symbols_by_type, symbol_node_payload
+from repo_mcp.symbols.lint import LINT_RULES, lint_symbols
+from repo_mcp.symbols.markdown import render_markdown_overview
+from repo_mcp.symbols.models import Diagnostic, ExportSummary, FileSymbols, SkippedFile
+from repo_mcp.symbols.partial import merge_partial_types, partial_type_payload
+from repo_mcp.symbols.pipeline import link_symbol_groups
+from repo_mcp.symbols.sarif import render_sarif
+from repo_mcp.symbols.sqlite import write_sqlite_export
+
+EXPORT_VERSION = 1
+EXPORT_FORMATS = OUTPUT_FORMATS
+_EXPORT_EXTENSIONS = {
+    "json": "json",
+    "jsonl": "jsonl",
+    "markdown": "md",
+    "sarif": "sarif",
+    "dot": "dot",
+    "sqlite": "db",
+}
+
+
+def export_filename(export_format: str) -> str:
+    """Return the artifact file name used for an export format."""
+    return f"symbols.{_EXPORT_EXTENSIONS[export_format]}"
+
+
+def file_symbols_payload(group: FileSymbols, *, group_by_type: bool = False) -> dict[str, object]:
+    """Return the JSON-ready payload for one file's symbol group.
+
+    With group_by_type, symbols nest under their type in `children` lists.
+    """
+    if group_by_type:
+        symbols = [symbol_node_payload(node) for node in group_symbols_by_type(group.symbols)]
+    else:
+        symbols = [outline_symbol_payload(symbol) for symbol in group.symbols]
+    return {
+        "path": group.path,
+        "language": group.language,
+        "symbols": symbols,
+        "imports": imports_payload(group.imports),
+    }
+
+
+def imports_payload(imports: Iterable[FileImport] | None) -> list[dict[str, object]] | None:
+    """Return JSON-ready import records, or None when imports were not extracted."""
+    if imports is None:
+        return None
+    return [file_import_payload(record) for record in imports]
+
+
+class JsonlSymbolWriter:
+    """Write one file symbol group per line, flushing after every line."""
+
+    def __init__(self, handle: TextIO, *, group_by_type: bool = False) -> None:
+        self._handle = handle
+        self._group_by_type = group_by_type
+        self.groups_written = 0
+        self.symbols_written = 0
+
+    def write(self, group: FileSymbols) -> None:
+        """Serialize and flush one file symbol group as a single JSON line."""
+        line = json.dumps(
+            file_symbols_payload(group, group_by_type=self._group_by_type), sort_keys=True
+        )
+        self._handle.write(line)
+        self._handle.write("\n")
+        self._handle.flush()
+        self.groups_written += 1
+        self.symbols_written += len(group.symbols)
+
+
+def write_symbol_export(
+    groups: Iterable[FileSymbols],
+    destination: Path,
+    export_format: str,
+    *,
+    graph_level: str = "package",
+    diagnostics: Sequence[Diagnostic] = (),
+    skipped_files: Sequence[SkippedFile] = (),
+    group_by_type: bool = False,
+    kinds: frozenset[str] | None = None,
+) -> ExportSummary:
+    """Write scanned symbol groups to destination in the requested format.
+
+    Files without symbols are counted as scanned but not written. The `sarif`
+    format writes the findings of the built-in lint rules instead of symbols,
+    the `dot` format a Graphviz graph at graph_level, and the `sqlite` format
+    upserts symbols, relationship edges, and imports into a database at
+    destination, updating an earlier export in place. The `json` document
+    lists diagnostics and skipped_files after the files; both are read once
+    groups are consumed, so the scan may still be filling them while the
+    export runs. It also carries partial types merged across files. Every
+    format runs `link_symbol_groups` over the files of each package, like the
+    other symbol tools; `jsonl` runs it on each file alone, as it writes files
+    before their package is complete.
+    group_by_type nests methods and members under their type in the `json`,
+    `jsonl`, and `markdown` formats; symbol counts still include every nested
+    symbol. kinds, a `kind_allowlist` result, is passed to
+    `link_symbol_groups`; files left without symbols are not written.
+    """
+    if export_format not in EXPORT_FORMATS:
+        raise ValueError(f"Unsupported symbol export format: {export_format}")
+    destination.parent.mkdir(parents=True, exist_ok=True)
+    files_scanned = 0
+    if export_format == "jsonl":
+        with destination.open("w", encoding="utf-8") as handle:
+            writer = JsonlSymbolWriter(handle, group_by_type=group_by_type)
+            for group in groups:
+                files_scanned += 1
+                if not group.symbols:
+                    continue
+                (linked,) = link_symbol_groups([group], kinds)
+                if linked.symbols:
+                    writer.write(linked)
+        return ExportSummary(
+            format=export_format,
+            artifact_path=destination.as_posix(),
+            files_scanned=files_scanned,
+            files_exported=writer.groups_written,
+            symbol_count=writer.symbols_written,
+        )
+
+    if export_format in {"markdown", "sarif", "dot", "sqlite"}:
+        written: list[FileSymbols] = []
+        for group in groups:
+            files_scanned += 1
+            if group.symbols:
+                written.append(group)
+        written = [group for group in link_symbol_groups(written, kinds) if group.symbols]
+        if export_format == "sqlite":
+            write_sqlite_export(written, destination)
+            return ExportSummary(
+                format=export_format,
+                artifact_path=destination.as_posix(),
+                files_scanned=files_scanned,
+                files_exported=len(written),
+                symbol_count=sum(len(group.symbols) for group in written),
+            )
+        with destination.open("w", encoding="utf-8") as handle:
+            if export_format == "markdown":
+                handle.write(render_markdown_overview(written, group_by_type=group_by_type))
+            elif export_format == "dot":
+                handle.write(render_dot(written, graph_level))
+            else:
+                sarif = render_sarif(lint_symbols(written, LINT_RULES), LINT_RULES)
+                json.dump(sarif, handle, sort_keys=True, indent=2)
+                handle.write("\n")
+        return ExportSummary(
+            format=export_format,
+            artifact_path=destination.as_posix(),
+            files_scanned=files_scanned,
+            files_exported=len(written),
+            symbol_count=sum(len(group.symbols) for group in written),
+        )
+
+    with_symbols: list[FileSymbols] = []
+    for group in groups:
+        files_scanned += 1
+        if group.symbols:
+            with_symbols.append(group)
+    with_symbols = [group for group in link_symbol_groups(with_symbols, kinds) if group.symbols]
+    exported = [
+        file_symbols_payload(group, group_by_type=group_by_type) for group in with_symbols
+    ]
+    symbol_count = sum(len(group.symbols) for group in with_symbols)
+    payload = {
+        "export_version": EXPORT_VERSION,
+        "files": exported,
+        "diagnostics": [asdict(item) for item in diagnostics],
+        "skipped_files": [asdict(item) for item in skipped_files],
+        "partial_types": [
+            partial_type_payload(item) for item in merge_partial_types(with_symbols)
+        ],
+    }
+    with destination.open("w", encoding="utf-8") as handle:
+        json.dump(payload, handle, sort_keys=True, indent=2)
+        handle.write("\n")
+    return ExportSummary(
+        format=export_format,
+        artifact_path=destination.as_posix(),
+        files_scanned=files_scanned,
+        files_exported=len(exported),
+        symbol_count=symbol_count,
+    )
@@ -0,0 +1,47 @@
+"""Nesting of member symbols under their declaring type."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+
+from repo_mcp.adapters.base import OutlineSymbol, outline_symbol_payload
+from repo_mcp.symbols.models import SymbolNode
+
+TYPE_KINDS = frozenset(
+    {"class", "enum", "interface", "object", "record", "struct", "trait", "type", "type_alias"}
+)
+
+
+def group_symbols_by_type(symbols: Sequence[OutlineSymbol]) -> tuple[SymbolNode, ...]:
+    """Nest each symbol under the type its `parent_symbol` names.
+
+    Only types declared in the same sequence (one file) are used, the first
+    declaration winning when a name repeats, so methods whose receiver type is
+    declared in another file stay at the top level with free functions.
+    Children keep outline order and nest recursively.
+    """
+    types: dict[str, OutlineSymbol] = {}
+    for symbol in symbols:
+        if symbol.kind in TYPE_KINDS:
+            types.setdefault(symbol.name, symbol)
+    children: dict[str, list[OutlineSymbol]] = {}
+    top_level: list[OutlineSymbol] = []
+    for symbol in symbols:
+        parent = types.get(symbol.parent_symbol) if symbol.parent_symbol else None
+        if parent is not None and parent is not symbol:
+            children.setdefault(parent.name, []).append(symbol)
+        else:
+            top_level.append(symbol)
+
+    def node(symbol: OutlineSymbol) -> SymbolNode:
+        nested = children.get(symbol.name, ()) if types.get(symbol.name) is symbol else ()
+        return SymbolNode(symbol=symbol, children=tuple(node(child) for child in nested))
+
+    return tuple(node(symbol) for symbol in top_level)
+
+
+def symbol_node_payload(node: SymbolNode) -> dict[str, object]:
+    """Return the JSON-ready payload for a symbol with a nested `children` list."""
+    payload = outline_symbol_payload(node.symbol)
+    payload["children"] = [symbol_node_payload(child) for child in node.children]
+    return payload
@@ -0,0 +1,104 @@
+"""Built-in API lint rules evaluated over scanned symbols."""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Iterable
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.symbols.models import FileSymbols, LintFinding, LintRule
+
+LINT_LEVELS = ("note", "warning", "error")
+
+# Adapters that extract doc comments; elsewhere a missing doc says nothing.
+_DOC_LANGUAGES = frozenset({"go_lexical", "python"})
+_UNDOCUMENTED_SKIP_KINDS = frozenset({"embedded", "impl", "namespace", "variant"})
+_TODO_RE = re.compile(r"\b(?:TODO|FIXME)\b")
+
+
+def _undocumented_exported_symbol(symbol: OutlineSymbol) -> str | None:
+    if symbol.visibility != "public" or symbol.doc or symbol.is_test:
+        return None
+    if symbol.kind in _UNDOCUMENTED_SKIP_KINDS:
+        return None
+    return f"Exported {symbol.kind} `{symbol.name}` has no doc comment."
+
+
+def _todo_in_doc_comment(symbol: OutlineSymbol) -> str | None:
+    if symbol.doc is None:
+        return None
+    marker = _TODO_RE.search(symbol.doc)
+    if marker is None:
+        return None
+    return f"Doc comment of `{symbol.name}` contains {marker.group(0)}."
+
+
+LINT_RULES: tuple[LintRule, ...] = (
+    LintRule(
+        rule_id="undocumented-exported-symbol",
+        summary="Exported symbol has no doc comment.",
+        level="warning",
+        check=_undocumented_exported_symbol,
+        languages=_DOC_LANGUAGES,
+    ),
+    LintRule(
+        rule_id="todo-in-doc-comment",
+        summary="Doc comment contains a TODO or FIXME marker.",
+        level="note",
+        check=_todo_in_doc_comment,
+    ),
+)
+
+
+def lint_symbols(
+    groups: Iterable[FileSymbols],
+    rules: Iterable[LintRule] = LINT_RULES,
+) -> list[LintFinding]:
+    """Evaluate rules against every symbol and return findings in a stable order.
+
+    Findings are sorted by path, start line, start column, rule id, and
+    symbol name. Raises ValueError for duplicate rule ids or a level outside
+    LINT_LEVELS.
+    """
+    selected = tuple(rules)
+    rule_ids = [rule.rule_id for rule in selected]
+    if len(set(rule_ids)) != len(rule_ids):
+        raise ValueError("lint rule ids must be unique")
+    for rule in selected:
+        if rule.level not in LINT_LEVELS:
+            raise ValueError(f"lint rule {rule.rule_id} has unsupported level {rule.level!r}")
+    findings: list[LintFinding] = []
+    for group in groups:
+        applicable = [
+            rule
+            for rule in selected
+            if rule.languages is None or group.language in rule.languages
+        ]
+        for symbol in group.symbols:
+            for rule in applicable:
+                message = rule.check(symbol)
+                if message is None:
+                    continue
+                findings.append(
+                    LintFinding(
+                        rule_id=rule.rule_id,
+                        path=group.path,
+                        symbol=symbol.name,
+                        start_line=symbol.start_line,
+                        end_line=symbol.end_line,
+                        start_col=symbol.start_col,
+                        message=message,
+                    )
+                )
+    findings.sort(key=_finding_sort_key)
+    return findings
+
+
+def _finding_sort_key(finding: LintFinding) -> tuple[str, int, int, str, str]:
+    return (
+        finding.path,
+        finding.start_line,
+        finding.start_col or 0,
+        finding.rule_id,
+        finding.symbol,
+    )
@@ -0,0 +1,265 @@
+"""Deterministic Markdown API overview rendering for symbol exports."""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Iterable
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.symbols.grouping import TYPE_KINDS, group_symbols_by_type
+from repo_mcp.symbols.models import FileSymbols, SymbolNode
+
+MARKDOWN_TITLE = "API Overview"
+
+_FUNCTION_KINDS = frozenset({"async_function", "async_method", "constructor", "function", "method"})
+_CONSTANT_KINDS = frozenset({"const", "constant"})
+_VARIABLE_KINDS = frozenset({"exported_variable", "var", "variable"})
+_SECTIONS: tuple[tuple[str, frozenset[str]], ...] = (
+    ("Types", TYPE_KINDS),
+    ("Functions", _FUNCTION_KINDS),
+    ("Constants", _CONSTANT_KINDS),
+    ("Variables", _VARIABLE_KINDS),
+)
+_OTHER_SECTION = "Other"
+_MEMBER_KINDS = frozenset({"embedded", "field", "property"})
+_FENCE_LANGUAGES = {
+    "cpp_lexical": "cpp",
+    "csharp_lexical": "csharp",
+    "go_lexical": "go",
+    "java_lexical": "java",
+    "kotlin_lexical": "kotlin",
+    "python": "python",
+    "rust_lexical": "rust",
+    "ts_js_lexical": "typescript",
+}
+_ANCHOR_INVALID_RE = re.compile(r"[^a-z0-9]+")
+
+
+def render_markdown_overview(
+    groups: Iterable[FileSymbols], *, group_by_type: bool = False
+) -> str:
+    """Render file symbol groups as a Markdown API overview.
+
+    Files appear in input order; within each file, symbols are grouped by
+    section (types, functions, constants, variables) in outline order. Struct
+    fields, embedded types, and class properties are listed under their parent
+    type. Symbols whose visibility is private are rendered inside a collapsible
+    "Internal" block. Every symbol gets an explicit anchor so the table of
+    contents links are stable regardless of the Markdown renderer.
+
+    With group_by_type, methods and nested types are rendered one heading
+    level below their type instead of in their own sections.
+    """
+    anchors = _AnchorAllocator()
+    contents: list[str] = []
+    body: list[str] = []
+    for group in groups:
+        if not group.symbols:
+            continue
+        file_anchor = anchors.allocate(f"file-{group.path}")
+        contents.append(f"- [{group.path}](#{file_anchor})")
+        body.extend(["", f'<a id="{file_anchor}"></a>', "", f"## {group.path}"])
+        members = _members_by_parent(group.symbols)
+        nested: dict[int, tuple[SymbolNode, ...]] = {}
+        if group_by_type:
+            nodes = group_symbols_by_type(group.symbols)
+            candidates = [node.symbol for node in nodes]
+            _collect_children(nodes, nested)
+        else:
+            candidates = list(group.symbols)
+        top_level = [
+            symbol
+            for symbol in candidates
+            if symbol.kind not in _MEMBER_KINDS or symbol.parent_symbol not in members
+        ]
+        exported = [symbol for symbol in top_level if symbol.visibility != "private"]
+        internal = [symbol for symbol in top_level if symbol.visibility == "private"]
+        fence = _FENCE_LANGUAGES.get(group.language, "text")
+        for symbols, heading_level, is_internal in ((exported, 3, False), (internal, 4, True)):
+            if not symbols:
+                continue
+            if is_internal:
+                body.extend(["", "<details>", "<summary>Internal</summary>"])
+            for section, section_symbols in _sections(symbols):
+                body.extend(["", f"{'#' * heading_level} {section}"])
+                for symbol in section_symbols:
+                    _render_entry(
+                        symbol,
+                        group_path=group.path,
+                        depth=0,
+                        heading_level=heading_level + 1,
+                        is_internal=is_internal,
+                        fence=fence,
+                        anchors=anchors,
+                        members=members,
+                        nested=nested,
+                        contents=contents,
+                        body=body,
+                    )
+            if is_internal:
+                body.extend(["", "</details>"])
+
+    lines = [f"# {MARKDOWN_TITLE}", "", "## Contents", ""]
+    lines.extend(contents or ["_No symbols found._"])
+    lines.extend(body)
+    return "\n".join(lines) + "\n"
+
+
+def _render_entry(
+    symbol: OutlineSymbol,
+    *,
+    group_path: str,
+    depth: int,
+    heading_level: int,
+    is_internal: bool,
+    fence: str,
+    anchors: _AnchorAllocator,
+    members: dict[str, tuple[OutlineSymbol, ...]],
+    nested: dict[int, tuple[SymbolNode, ...]],
+    contents: list[str],
+    body: list[str],
+) -> None:
+    anchor = anchors.allocate(f"{group_path}-{symbol.name}")
+    label = symbol.name
+    if is_internal or (depth and symbol.visibility == "private"):
+        label += " (internal)"
+    contents.append(f"{'  ' * (depth + 1)}- [{label}](#{anchor})")
+    body.extend(
+        _render_symbol(
+            symbol,
+            anchor=anchor,
+            heading_level=heading_level,
+            fence=fence,
+            members=members.get(symbol.name, ()),
+        )
+    )
+    for child in nested.get(id(symbol), ()):
+        if child.symbol.kind in _MEMBER_KINDS and child.symbol.parent_symbol in members:
+            continue
+        _render_entry(
+            child.symbol,
+            group_path=group_path,
+            depth=depth + 1,
+            heading_level=min(heading_level + 1, 6),
+            is_internal=is_internal,
+            fence=fence,
+            anchors=anchors,
+            members=members,
+            nested=nested,
+            contents=contents,
+            body=body,
+        )
+
+
+def _collect_children(
+    nodes: tuple[SymbolNode, ...], nested: dict[int, tuple[SymbolNode, ...]]
+) -> None:
+    for node in nodes:
+        if node.children:
+            nested[id(node.symbol)] = node.children
+            _collect_children(node.children, nested)
+
+
+def _render_symbol(
+    symbol: OutlineSymbol,
+    *,
+    anchor: str,
+    heading_level: int,
+    fence: str,
+    members: tuple[OutlineSymbol, ...],
+) -> list[str]:
+    lines = [
+        "",
+        f'<a id="{anchor}"></a>',
+        "",
+        f"{'#' * heading_level} `{symbol.name}`",
+        "",
+        f"```{fence}",
+        _declaration_line(symbol),
+        "```",
+        "",
+        f"_{_kind_line(symbol)}, {_line_span(symbol)}_",
+    ]
+    if symbol.doc:
+        lines.extend(["", symbol.doc])
+    if symbol.examples and symbol.role != "example":
+        lines.extend(["", "Examples:"])
+        for snippet in symbol.examples:
+            lines.extend(["", f"```{fence}", snippet, "```"])
+    if members:
+        lines.append("")
+        for member in members:
+            short_name = member.name.rsplit(".", 1)[-1]
+            entry = f"- `{short_name}`"
+            if member.signature:
+                entry += f" `{member.signature}`"
+            if member.visibility == "private":
+                entry += " (internal)"
+            if member.doc:
+                entry += f": {member.doc.splitlines()[0]}"
+            lines.append(entry)
+    return lines
+
+
+def _kind_line(symbol: OutlineSymbol) -> str:
+    if symbol.receiver is None:
+        return symbol.kind
+    receiver = "pointer" if symbol.receiver.startswith("*") else "value"
+    return f"{symbol.kind}, {receiver} receiver `{symbol.receiver}`"
+
+
+def _line_span(symbol: OutlineSymbol) -> str:
+    if symbol.start_line == symbol.end_line:
+        return f"line {symbol.start_line}"
+    return f"lines {symbol.start_line}-{symbol.end_line}"
+
+
+def _declaration_line(symbol: OutlineSymbol) -> str:
+    short_name = symbol.name.rsplit(".", 1)[-1]
+    if symbol.kind in {"method", "async_method", "constructor"} and symbol.parent_symbol:
+        short_name = f"{symbol.parent_symbol.rsplit('.', 1)[-1]}.{short_name}"
+    signature = symbol.signature or ""
+    return f"{symbol.kind} {short_name}{signature}"
+
+
+def _sections(symbols: list[OutlineSymbol]) -> list[tuple[str, list[OutlineSymbol]]]:
+    buckets: dict[str, list[OutlineSymbol]] = {}
+    for symbol in symbols:
+        buckets.setdefault(_section_for_kind(symbol.kind), []).append(symbol)
+    ordered = [name for name, _ in _SECTIONS] + [_OTHER_SECTION]
+    return [(name, buckets[name]) for name in ordered if name in buckets]
+
+
+def _section_for_kind(kind: str) -> str:
+    for name, kinds in _SECTIONS:
+        if kind in kinds:
+            return name
+    return _OTHER_SECTION
+
+
+def _members_by_parent(
+    symbols: tuple[OutlineSymbol, ...],
+) -> dict[str, tuple[OutlineSymbol, ...]]:
+    names = {symbol.name for symbol in symbols if symbol.kind not in _MEMBER_KINDS}
+    grouped: dict[str, list[OutlineSymbol]] = {}
+    for symbol in symbols:
+        if symbol.kind in _MEMBER_KINDS and symbol.parent_symbol in names:
+            grouped.setdefault(symbol.parent_symbol, []).append(symbol)
+    return {parent: tuple(members) for parent, members in grouped.items()}
+
+
+class _AnchorAllocator:
+    """Allocate unique, deterministic anchor ids in render order."""
+
+    def __init__(self) -> None:
+        self._used: set[str] = set()
+
+    def allocate(self, raw: str) -> str:
+        base = _ANCHOR_INVALID_RE.sub("-", raw.lower()).strip("-") or "symbol"
+        anchor = base
+        suffix = 2
+        while anchor in self._used:
+            anchor = f"{base}-{suffix}"
+            suffix += 1
+        self._used.add(anchor)
+        return anchor
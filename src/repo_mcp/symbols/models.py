@@ -0,0 +1,273 @@
+"""Typed models for repository-wide symbol scans and exports."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+from dataclasses import dataclass
+
+from repo_mcp.adapters.base import FileImport, OutlineSymbol, ParseDiagnostic
+from repo_mcp.index.models import FileRecord
+
+
+@dataclass(slots=True, frozen=True)
+class FileSymbols:
+    """Outline symbols and imports extracted from one repository file.
+
+    `imports` is None when the file's adapter does not extract imports.
+    `line_count` is None when the group was not read from a scanned file,
+    for example when loaded from an export artifact. `diagnostics` holds the
+    parse problems the adapter reported alongside its best-effort symbols.
+    """
+
+    path: str
+    language: str
+    symbols: tuple[OutlineSymbol, ...]
+    imports: tuple[FileImport, ...] | None = None
+    line_count: int | None = None
+    diagnostics: tuple[ParseDiagnostic, ...] = ()
+
+
+@dataclass(slots=True, frozen=True)
+class SymbolNode:
+    """One symbol with the members and methods nested under it by type grouping."""
+
+    symbol: OutlineSymbol
+    children: tuple[SymbolNode, ...] = ()
+
+
+@dataclass(slots=True, frozen=True)
+class PartialTypeMember:
+    """Member of a partial type, with the file of the declaration it is in."""
+
+    path: str
+    kind: str
+    name: str
+    start_line: int
+
+
+@dataclass(slots=True, frozen=True)
+class PartialType:
+    """Type declared `partial`, merged across every file that declares a part of it."""
+
+    name: str
+    kind: str
+    paths: tuple[str, ...]
+    members: tuple[PartialTypeMember, ...]
+
+
+@dataclass(slots=True, frozen=True)
+class Diagnostic:
+    """Parse problem or adapter failure recorded for one scanned file.
+
+    `line` is None when the problem applies to the whole file, including
+    adapter failures, whose file is left out of the scan results.
+    """
+
+    path: str
+    line: int | None
+    message: str
+
+
+@dataclass(slots=True, frozen=True)
+class SkippedFile:
+    """File left out of a symbol scan because it exceeds the size limit, with its size in bytes."""
+
+    path: str
+    size: int
+
+
+@dataclass(slots=True, frozen=True)
+class ExportSummary:
+    """Deterministic summary of one written symbol export artifact."""
+
+    format: str
+    artifact_path: str
+    files_scanned: int
+    files_exported: int
+    symbol_count: int
+    files_failed: int = 0
+    files_cached: int = 0
+    diagnostics: tuple[Diagnostic, ...] = ()
+
+
+@dataclass(slots=True, frozen=True)
+class CachedFileSymbols:
+    """Symbol group persisted with the file record it was extracted from.
+
+    `kinds` is the sorted kind allowlist the adapter was asked for when it
+    returned only those kinds, and None when group holds every symbol.
+    """
+
+    record: FileRecord
+    group: FileSymbols
+    kinds: tuple[str, ...] | None = None
+
+
+@dataclass(slots=True, frozen=True)
+class PackedFile:
+    """Manifest entry for one file considered by a token-budgeted symbol pack.
+
+    `status` is `full` when every symbol fit, `partial` when some did, and
+    `dropped` when none did.
+    """
+
+    path: str
+    status: str
+    symbols_included: int
+    symbols_total: int
+
+
+@dataclass(slots=True, frozen=True)
+class SymbolPack:
+    """Compact symbol rendering that fits a token budget, plus its manifest."""
+
+    text: str
+    max_tokens: int
+    estimated_tokens: int
+    symbols_included: int
+    symbols_total: int
+    files: tuple[PackedFile, ...]
+
+
+@dataclass(slots=True, frozen=True)
+class SymbolQuery:
+    """Filters applied to scanned symbols; None leaves a dimension unfiltered."""
+
+    kind: str | None = None
+    visibility: str | None = None
+    returns: str | None = None
+    package: str | None = None
+    deprecated: bool | None = None
+    min_complexity: int | None = None
+
+
+@dataclass(slots=True, frozen=True)
+class SymbolSearchHit:
+    """One ranked `repo.search_symbols` match.
+
+    `matched_fields` lists the indexed fields (`name`, `signature`, `doc`)
+    that matched at least one query term, in that order.
+    """
+
+    path: str
+    symbol: OutlineSymbol
+    score: int
+    matched_fields: tuple[str, ...]
+
+
+@dataclass(slots=True, frozen=True)
+class LanguageSummary:
+    """File, line, and symbol totals for one adapter language."""
+
+    language: str
+    files: int
+    lines: int
+    symbols: int
+
+
+@dataclass(slots=True, frozen=True)
+class PackageSummary:
+    """Symbol totals for one package, or one directory when files report none.
+
+    `kinds` pairs each symbol kind with its count, sorted by kind.
+    """
+
+    package: str
+    files: int
+    symbols: int
+    exported: int
+    unexported: int
+    kinds: tuple[tuple[str, int], ...]
+
+
+@dataclass(slots=True, frozen=True)
+class FileLineCount:
+    """Line count of one scanned file, used to rank the largest files."""
+
+    path: str
+    language: str
+    lines: int
+
+
+@dataclass(slots=True, frozen=True)
+class RepoSummary:
+    """Aggregate counts over a repository-wide symbol scan.
+
+    `exported` and `unexported` count symbols with `visibility` `public` and
+    `private`; symbols without visibility are in neither.
+    """
+
+    files: int
+    lines: int
+    symbols: int
+    exported: int
+    unexported: int
+    languages: tuple[LanguageSummary, ...]
+    kinds: tuple[tuple[str, int], ...]
+    packages: tuple[PackageSummary, ...]
+    largest_files: tuple[FileLineCount, ...]
+
+
+@dataclass(slots=True, frozen=True)
+class SymbolChange:
+    """One added, removed, or changed symbol between two exports.
+
+    `before` is None for additions and `after` is None for removals.
+    """
+
+    path: str
+    kind: str
+    name: str
+    before: OutlineSymbol | None
+    after: OutlineSymbol | None
+    breaking: bool = False
+
+
+@dataclass(slots=True, frozen=True)
+class SymbolDiff:
+    """Symbol differences between two exports, each sorted by path, name, and kind."""
+
+    added: tuple[SymbolChange, ...]
+    removed: tuple[SymbolChange, ...]
+    changed: tuple[SymbolChange, ...]
+
+    @property
+    def breaking_count(self) -> int:
+        """Return the number of removed and changed entries flagged as breaking."""
+        return sum(entry.breaking for entry in self.removed + self.changed)
+
+
+@dataclass(slots=True, frozen=True)
+class LintFinding:
+    """One rule violation reported for a symbol at its captured position."""
+
+    rule_id: str
+    path: str
+    symbol: str
+    start_line: int
+    end_line: int
+    start_col: int | None
+    message: str
+
+
+@dataclass(slots=True, frozen=True)
+class LintRule:
+    """A named symbol check; `check` returns a finding message or None.
+
+    `languages` limits the rule to file groups from those adapters; None
+    applies it to every group.
+    """
+
+    rule_id: str
+    summary: str
+    level: str
+    check: Callable[[OutlineSymbol], str | None]
+    languages: frozenset[str] | None = None
+
+
+@dataclass(slots=True, frozen=True)
+class WatchBatch:
+    """Files whose content changed or that disappeared during one debounced burst."""
+
+    changed: tuple[str, ...]
+    removed: tuple[str, ...]
@@ -0,0 +1,112 @@
+"""Token-budgeted packing of repository symbols for prompt construction."""
+
+from __future__ import annotations
+
+import math
+from collections.abc import Callable, Iterable
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.symbols.models import FileSymbols, PackedFile, SymbolPack
+
+TokenEstimator = Callable[[str], int]
+DEFAULT_CHARS_PER_TOKEN = 4
+MAX_CHARS_PER_TOKEN = 16
+MAX_PACK_TOKENS = 1_000_000
+
+
+def char_ratio_estimator(chars_per_token: int = DEFAULT_CHARS_PER_TOKEN) -> TokenEstimator:
+    """Return an estimator that counts one token per `chars_per_token` characters, rounded up."""
+    if chars_per_token < 1:
+        raise ValueError("chars_per_token must be >= 1")
+
+    def estimate(text: str) -> int:
+        return math.ceil(len(text) / chars_per_token)
+
+    return estimate
+
+
+def pack_symbols(
+    groups: Iterable[FileSymbols],
+    max_tokens: int,
+    estimate_tokens: TokenEstimator | None = None,
+) -> SymbolPack:
+    """Select and render the most useful symbols without exceeding max_tokens.
+
+    Candidates are ranked public-with-doc, public, private-with-doc, then
+    private (symbols whose adapter reports no visibility count as public),
+    breaking ties by path and outline order. Symbols are taken in rank order
+    until the next one, together with its file header when the file is not
+    yet in the pack, would exceed the budget; selection stops there so the
+    ranking is never inverted to fill leftover space. The rendered text lists
+    files by path and symbols in outline order.
+    """
+    estimate = estimate_tokens or char_ratio_estimator()
+    ordered = sorted((group for group in groups if group.symbols), key=lambda item: item.path)
+    candidates: list[tuple[tuple[int, int, int], str, OutlineSymbol]] = []
+    for file_index, group in enumerate(ordered):
+        for symbol_index, symbol in enumerate(group.symbols):
+            rank = (_priority(symbol), file_index, symbol_index)
+            candidates.append((rank, group.path, symbol))
+    candidates.sort(key=lambda item: item[0])
+
+    selected: dict[str, set[int]] = {}
+    used_tokens = 0
+    for (_, _, symbol_index), path, symbol in candidates:
+        cost = estimate(_render_symbol(symbol))
+        if path not in selected:
+            cost += estimate(_render_header(path))
+        if used_tokens + cost > max_tokens:
+            break
+        selected.setdefault(path, set()).add(symbol_index)
+        used_tokens += cost
+
+    lines: list[str] = []
+    files: list[PackedFile] = []
+    for group in ordered:
+        indexes = selected.get(group.path, set())
+        if indexes:
+            lines.append(_render_header(group.path))
+            lines.extend(
+                _render_symbol(symbol)
+                for index, symbol in enumerate(group.symbols)
+                if index in indexes
+            )
+        if len(indexes) == len(group.symbols):
+            status = "full"
+        elif indexes:
+            status = "partial"
+        else:
+            status = "dropped"
+        files.append(
+            PackedFile(
+                path=group.path,
+                status=status,
+                symbols_included=len(indexes),
+                symbols_total=len(group.symbols),
+            )
+        )
+    return SymbolPack(
+        text="".join(lines),
+        max_tokens=max_tokens,
+        estimated_tokens=used_tokens,
+        symbols_included=sum(item.symbols_included for item in files),
+        symbols_total=sum(item.symbols_total for item in files),
+        files=tuple(files),
+    )
+
+
+def _priority(symbol: OutlineSymbol) -> int:
+    private = 2 if symbol.visibility == "private" else 0
+    undocumented = 0 if symbol.doc else 1
+    return private + undocumented
+
+
+def _render_header(path: str) -> str:
+    return f"# {path}\n"
+
+
+def _render_symbol(symbol: OutlineSymbol) -> str:
+    line = f"{symbol.kind} {symbol.name}{symbol.signature or ''} :{symbol.start_line}\n"
+    if symbol.doc:
+        line += f"  {symbol.doc.splitlines()[0]}\n"
+    return line
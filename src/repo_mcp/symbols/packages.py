@@ -0,0 +1,105 @@
+"""Package-wide linking of Go symbols declared in separate files."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+from dataclasses import replace
+from pathlib import PurePosixPath
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.adapters.go import GoLexicalAdapter, go_implements
+from repo_mcp.symbols.models import FileSymbols
+
+
+def link_package_symbols(groups: Iterable[FileSymbols]) -> list[FileSymbols]:
+    """Resolve Go `calls` and recompute `implements` across every file of each package.
+
+    A package is the Go files of one directory reporting the same `package`.
+    Adapters only see one file, so here a type in `service.go` gains
+    interfaces declared in `runner.go`, with methods and embedded types from
+    every file of the package counted. Call entries the adapter left as
+    written are qualified when they name a declaration in another file: a
+    bare `Build` becomes `worker.Build`, and `s.Run` in a method whose
+    `receiver_name` is `s` becomes `worker.Service.Run` when the receiver type
+    declares `Run`; calls through other variables stay as written. Bare calls
+    naming a package type are conversions and are dropped. Other groups are
+    returned unchanged, and groups are returned in input order.
+    """
+    materialized = list(groups)
+    packages: dict[tuple[str, str], list[int]] = {}
+    for index, group in enumerate(materialized):
+        if group.language != GoLexicalAdapter.name or not group.symbols:
+            continue
+        package = group.symbols[0].package
+        if package is None:
+            continue
+        directory = PurePosixPath(group.path).parent.as_posix()
+        packages.setdefault((directory, package), []).append(index)
+    linked = list(materialized)
+    for (_, package), members in sorted(packages.items()):
+        if len(members) < 2:
+            continue
+        members.sort(key=lambda index: materialized[index].path)
+        combined = [symbol for index in members for symbol in materialized[index].symbols]
+        declared = _package_declarations(combined, package)
+        resolved = iter(go_implements(combined, package))
+        for index in members:
+            group = materialized[index]
+            symbols = tuple(
+                _resolve_calls(next(resolved), package, declared) for _ in group.symbols
+            )
+            if symbols != group.symbols:
+                linked[index] = replace(group, symbols=symbols)
+    return linked
+
+
+def _package_declarations(
+    symbols: list[OutlineSymbol], package: str
+) -> dict[str, frozenset[str]]:
+    prefix = f"{package}."
+    local_names = {
+        kind: frozenset(
+            symbol.name.removeprefix(prefix) for symbol in symbols if symbol.kind == kind
+        )
+        for kind in ("function", "type")
+    }
+    local_names["method"] = frozenset(
+        symbol.name.removeprefix(prefix)
+        for symbol in symbols
+        if symbol.kind == "method" and symbol.receiver is not None
+    )
+    return local_names
+
+
+def _resolve_calls(
+    symbol: OutlineSymbol,
+    package: str,
+    declared: dict[str, frozenset[str]],
+) -> OutlineSymbol:
+    if not symbol.calls:
+        return symbol
+    prefix = f"{package}."
+    receiver_type = None
+    if symbol.kind == "method" and symbol.receiver is not None:
+        receiver_type = symbol.name.removeprefix(prefix).rpartition(".")[0]
+    calls: list[str] = []
+    for callee in symbol.calls:
+        parts = callee.split(".")
+        resolved: str | None = callee
+        if len(parts) == 1:
+            if callee in declared["type"]:
+                resolved = None
+            elif callee in declared["function"]:
+                resolved = prefix + callee
+        elif (
+            len(parts) == 2
+            and receiver_type
+            and parts[0] == symbol.receiver_name
+            and f"{receiver_type}.{parts[1]}" in declared["method"]
+        ):
+            resolved = f"{prefix}{receiver_type}.{parts[1]}"
+        if resolved is not None and resolved not in calls:
+            calls.append(resolved)
+    if tuple(calls) == symbol.calls:
+        return symbol
+    return replace(symbol, calls=tuple(calls))
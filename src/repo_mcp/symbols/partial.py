@@ -0,0 +1,57 @@
+"""Cross-file merge of partial type declarations."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+from dataclasses import asdict
+
+from repo_mcp.symbols.models import FileSymbols, PartialType, PartialTypeMember
+
+
+def merge_partial_types(groups: Iterable[FileSymbols]) -> tuple[PartialType, ...]:
+    """Merge the parts of each partial type by qualified name.
+
+    Every declaration flagged `partial` contributes its file, and the direct
+    members of all parts (symbols whose `parent_symbol` is the type) are
+    listed together in path then line order. Types are sorted by name.
+    """
+    materialized = list(groups)
+    kinds: dict[str, str] = {}
+    paths: dict[str, set[str]] = {}
+    for group in materialized:
+        for symbol in group.symbols:
+            if symbol.partial:
+                name = symbol.qualified_name or symbol.name
+                kinds.setdefault(name, symbol.kind)
+                paths.setdefault(name, set()).add(group.path)
+    members: dict[str, list[PartialTypeMember]] = {name: [] for name in kinds}
+    for group in materialized:
+        for symbol in group.symbols:
+            if symbol.parent_symbol in members and group.path in paths[symbol.parent_symbol]:
+                members[symbol.parent_symbol].append(
+                    PartialTypeMember(
+                        path=group.path,
+                        kind=symbol.kind,
+                        name=symbol.qualified_name or symbol.name,
+                        start_line=symbol.start_line,
+                    )
+                )
+    return tuple(
+        PartialType(
+            name=name,
+            kind=kinds[name],
+            paths=tuple(sorted(paths[name])),
+            members=tuple(
+                sorted(
+                    members[name],
+                    key=lambda item: (item.path, item.start_line, item.name, item.kind),
+                )
+            ),
+        )
+        for name in sorted(kinds)
+    )
+
+
+def partial_type_payload(partial_type: PartialType) -> dict[str, object]:
+    """Return the JSON-ready payload for one merged partial type."""
+    return asdict(partial_type)
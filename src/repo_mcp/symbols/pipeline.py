@@ -0,0 +1,31 @@
+"""Post-scan linking shared by every tool that reads scanned symbol groups."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+
+from repo_mcp.symbols.examples import attach_examples
+from repo_mcp.symbols.models import FileSymbols
+from repo_mcp.symbols.packages import link_package_symbols
+from repo_mcp.symbols.query import select_kinds
+from repo_mcp.symbols.typerefs import resolve_type_references
+
+
+def link_symbol_groups(
+    groups: Iterable[FileSymbols], kinds: frozenset[str] | None = None
+) -> list[FileSymbols]:
+    """Run package linking, reference resolution, and example linking, then select kinds.
+
+    Go `calls` and `implements` are linked across the files of each package
+    first, then type `references` are resolved and example functions attached
+    to their targets. kinds, a `kind_allowlist` result, narrows the groups
+    last, so kept symbols carry the same data whatever the allowlist. Every
+    tool reading a scan calls this, so the same tree gives the same answers
+    from `repo.export_symbols`, `repo.query_symbols`, and the other symbol
+    tools. Groups, including those left without symbols, are returned in
+    input order.
+    """
+    linked = attach_examples(resolve_type_references(link_package_symbols(groups)))
+    if kinds is None:
+        return linked
+    return select_kinds(linked, kinds)
@@ -0,0 +1,87 @@
+"""Throttled scan progress reporting for a terminal or a log stream."""
+
+from __future__ import annotations
+
+import time
+from collections.abc import Callable
+from typing import TextIO
+
+PROGRESS_TTY_INTERVAL_SECONDS = 0.1
+PROGRESS_LOG_INTERVAL_SECONDS = 2.0
+_SPINNER_FRAMES = "|/-\\"
+_PROGRESS_LABEL = "Scanning symbols"
+
+
+class ProgressReporter:
+    """Report `done/total` file counts, at most once per interval.
+
+    On a terminal the line is redrawn in place with a spinner; otherwise each
+    update is written as its own line, so a CI log gets a bounded number of
+    lines. The first and the final update are always written. Only counts are
+    written, never paths or file contents.
+    """
+
+    def __init__(
+        self,
+        stream: TextIO,
+        *,
+        tty: bool,
+        interval_seconds: float | None = None,
+        clock: Callable[[], float] = time.monotonic,
+    ) -> None:
+        self._stream = stream
+        self._tty = tty
+        if interval_seconds is None:
+            interval_seconds = (
+                PROGRESS_TTY_INTERVAL_SECONDS if tty else PROGRESS_LOG_INTERVAL_SECONDS
+            )
+        self._interval = interval_seconds
+        self._clock = clock
+        self._last_write: float | None = None
+        self._frame = 0
+        self._width = 0
+
+    def __call__(self, done: int, total: int) -> None:
+        """Record that done of total files are processed; the scan calls this per file."""
+        finished = done >= total
+        now = self._clock()
+        if (
+            not finished
+            and self._last_write is not None
+            and now - self._last_write < self._interval
+        ):
+            return
+        self._last_write = now
+        percent = 100 if total == 0 else done * 100 // total
+        message = f"{_PROGRESS_LABEL}: {done}/{total} files ({percent}%)"
+        if self._tty:
+            spinner = "done" if finished else _SPINNER_FRAMES[self._frame % len(_SPINNER_FRAMES)]
+            self._frame += 1
+            line = f"{message} {spinner}"
+            padding = " " * max(0, self._width - len(line))
+            self._width = len(line)
+            self._stream.write(f"\r{line}{padding}")
+            if finished:
+                self._stream.write("\n")
+                self._width = 0
+        else:
+            self._stream.write(f"{message}\n")
+        self._stream.flush()
+
+
+def progress_enabled(mode: str, stream: TextIO) -> bool:
+    """Return whether progress is shown for an `output.progress` mode.
+
+    `auto` shows progress only when stream is a terminal.
+    """
+    if mode == "always":
+        return True
+    if mode == "never":
+        return False
+    return stream_is_tty(stream)
+
+
+def stream_is_tty(stream: TextIO) -> bool:
+    """Return True when stream is attached to a terminal."""
+    isatty = getattr(stream, "isatty", None)
+    return bool(isatty()) if callable(isatty) else False
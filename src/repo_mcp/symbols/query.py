@@ -0,0 +1,97 @@
+"""Deterministic filtering of scanned symbols by kind, visibility, returns, package, and more."""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Iterable
+from dataclasses import replace
+from pathlib import PurePosixPath
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.config import SYMBOL_KIND_GROUPS
+from repo_mcp.symbols.models import FileSymbols, SymbolQuery
+
+QUERY_FORMATS = ("json", "markdown")
+_WHITESPACE_RE = re.compile(r"\s+")
+
+
+def query_symbols(groups: Iterable[FileSymbols], query: SymbolQuery) -> list[FileSymbols]:
+    """Return groups narrowed to matching symbols, dropping groups left empty."""
+    matched: list[FileSymbols] = []
+    for group in groups:
+        symbols = tuple(
+            symbol for symbol in group.symbols if symbol_matches(symbol, group.path, query)
+        )
+        if symbols:
+            matched.append(replace(group, symbols=symbols))
+    return matched
+
+
+def kind_allowlist(kinds: Iterable[str]) -> frozenset[str]:
+    """Return the adapter `kind` values covered by the kind group names in kinds.
+
+    Group names are the keys of `SYMBOL_KIND_GROUPS` (for example `type` covers
+    `class`, `struct`, and `record`); unknown names cover nothing.
+    """
+    return frozenset().union(*(SYMBOL_KIND_GROUPS.get(name, frozenset()) for name in kinds))
+
+
+def kind_allowed(symbol: OutlineSymbol, allowed: frozenset[str]) -> bool:
+    """Return True when symbol's kind is in allowed, a `kind_allowlist` result.
+
+    Go reports interfaces as `type` symbols with `decl_context` `interface`;
+    they count as `interface` rather than `type`, so the `interface` and `type`
+    groups select the same declarations in every language.
+    """
+    if symbol.kind == "type" and symbol.decl_context == "interface":
+        return "interface" in allowed
+    return symbol.kind in allowed
+
+
+def select_kinds(groups: Iterable[FileSymbols], allowed: frozenset[str]) -> list[FileSymbols]:
+    """Return groups narrowed to the symbols `kind_allowed` keeps, empty groups included."""
+    selected: list[FileSymbols] = []
+    for group in groups:
+        symbols = tuple(symbol for symbol in group.symbols if kind_allowed(symbol, allowed))
+        selected.append(group if symbols == group.symbols else replace(group, symbols=symbols))
+    return selected
+
+
+def symbol_matches(symbol: OutlineSymbol, path: str, query: SymbolQuery) -> bool:
+    """Return True when symbol, declared in path, satisfies every set filter.
+
+    `returns` matches when any parsed return type equals the requested type
+    after whitespace normalization; symbols without parsed return types never
+    match it. `package` matches a symbol whose `package` field equals it, whose
+    name starts with `<package>.`, or whose file sits directly in a directory
+    of that name. `deprecated` True keeps only symbols flagged deprecated;
+    False drops them. `min_complexity` keeps symbols whose `complexity` is at
+    least that value; symbols without a complexity never match it.
+    """
+    if query.kind is not None and symbol.kind != query.kind:
+        return False
+    if query.visibility is not None and symbol.visibility != query.visibility:
+        return False
+    if query.deprecated is not None and (symbol.deprecated is True) != query.deprecated:
+        return False
+    if query.min_complexity is not None and (
+        symbol.complexity is None or symbol.complexity < query.min_complexity
+    ):
+        return False
+    if query.returns is not None:
+        wanted = _normalize_type(query.returns)
+        if not any(_normalize_type(item) == wanted for item in symbol.returns or ()):
+            return False
+    if query.package is not None:
+        matched = (
+            symbol.package == query.package
+            or PurePosixPath(path).parent.name == query.package
+            or symbol.name.startswith(f"{query.package}.")
+        )
+        if not matched:
+            return False
+    return True
+
+
+def _normalize_type(text: str) -> str:
+    return _WHITESPACE_RE.sub("", text)
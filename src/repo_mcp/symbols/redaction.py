@@ -0,0 +1,33 @@
+"""Redaction of secret-like text in extracted symbol fields."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+from dataclasses import replace
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.security import Redactor
+
+
+def redact_symbols(symbols: Iterable[OutlineSymbol], redactor: Redactor) -> list[OutlineSymbol]:
+    """Return symbols with secrets removed from their free-text fields.
+
+    `value`, `doc`, `deprecation_note`, `signature` (which carries default
+    argument values), and `examples` are redacted; names, kinds, and line
+    spans are kept.
+    """
+    return [
+        replace(
+            symbol,
+            value=redactor.redact(symbol.value),
+            doc=redactor.redact(symbol.doc),
+            deprecation_note=redactor.redact(symbol.deprecation_note),
+            signature=redactor.redact(symbol.signature),
+            examples=(
+                tuple(redactor.redact(snippet) or "" for snippet in symbol.examples)
+                if symbol.examples is not None
+                else None
+            ),
+        )
+        for symbol in symbols
+    ]
@@ -0,0 +1,78 @@
+"""SARIF 2.1.0 rendering of symbol lint findings."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+
+from repo_mcp.symbols.models import LintFinding, LintRule
+
+SARIF_VERSION = "2.1.0"
+SARIF_SCHEMA = "https://json.schemastore.org/sarif-2.1.0.json"
+SARIF_TOOL_NAME = "repo-interrogator"
+SARIF_SRCROOT = "%SRCROOT%"
+
+
+def render_sarif(
+    findings: Iterable[LintFinding],
+    rules: Iterable[LintRule],
+) -> dict[str, object]:
+    """Return a SARIF 2.1.0 log with one run and one result per finding.
+
+    Every rule is listed in the tool driver, in the given order, whether or not
+    it produced findings. Result locations are repository-relative URIs based
+    on `%SRCROOT%` so code-scanning UIs resolve them against the checkout.
+    """
+    ordered_rules = list(rules)
+    rule_index = {rule.rule_id: index for index, rule in enumerate(ordered_rules)}
+    levels = {rule.rule_id: rule.level for rule in ordered_rules}
+    results: list[dict[str, object]] = []
+    for finding in findings:
+        region: dict[str, object] = {
+            "startLine": finding.start_line,
+            "endLine": finding.end_line,
+        }
+        if finding.start_col is not None:
+            region["startColumn"] = finding.start_col
+        results.append(
+            {
+                "ruleId": finding.rule_id,
+                "ruleIndex": rule_index[finding.rule_id],
+                "level": levels[finding.rule_id],
+                "message": {"text": finding.message},
+                "locations": [
+                    {
+                        "physicalLocation": {
+                            "artifactLocation": {
+                                "uri": finding.path,
+                                "uriBaseId": SARIF_SRCROOT,
+                            },
+                            "region": region,
+                        },
+                        "logicalLocations": [{"fullyQualifiedName": finding.symbol}],
+                    }
+                ],
+            }
+        )
+    return {
+        "$schema": SARIF_SCHEMA,
+        "version": SARIF_VERSION,
+        "runs": [
+            {
+                "tool": {
+                    "driver": {
+                        "name": SARIF_TOOL_NAME,
+                        "rules": [_rule_descriptor(rule) for rule in ordered_rules],
+                    }
+                },
+                "results": results,
+            }
+        ],
+    }
+
+
+def _rule_descriptor(rule: LintRule) -> dict[str, object]:
+    return {
+        "id": rule.rule_id,
+        "shortDescription": {"text": rule.summary},
+        "defaultConfiguration": {"level": rule.level},
+    }
@@ -0,0 +1,332 @@
+"""Deterministic repository-wide symbol scanning."""
+
+from __future__ import annotations
+
+import os
+from collections import deque
+from collections.abc import Callable, Iterator
+from concurrent.futures import Future, ProcessPoolExecutor
+from concurrent.futures.process import BrokenProcessPool
+from contextlib import ExitStack
+from dataclasses import replace
+from multiprocessing import get_context
+from pathlib import Path
+
+from repo_mcp.adapters import AdapterRegistry
+from repo_mcp.adapters.base import normalize_and_sort_diagnostics, normalize_and_sort_symbols
+from repo_mcp.adapters.go_build import go_constraints_match
+from repo_mcp.config import IndexConfig
+from repo_mcp.index.discovery import discover_files
+from repo_mcp.index.models import FileRecord
+from repo_mcp.security import (
+    PolicyBlockedError,
+    Redactor,
+    SecurityLimits,
+    enforce_file_access_policy,
+    is_denylisted,
+)
+from repo_mcp.symbols.cache import load_symbol_cache, write_symbol_cache
+from repo_mcp.symbols.models import CachedFileSymbols, Diagnostic, FileSymbols, SkippedFile
+from repo_mcp.symbols.query import kind_allowlist
+from repo_mcp.symbols.redaction import redact_symbols
+
+_IN_FLIGHT_PER_WORKER = 4
+_POOL_MIN_UNCACHED_FILES = 32
+_ScanOutcome = CachedFileSymbols | Diagnostic | SkippedFile | str
+
+
+def resolve_scan_concurrency(concurrency: int | None) -> int:
+    """Return the worker count for a scan, defaulting to the host CPU count."""
+    if concurrency is not None and concurrency >= 1:
+        return concurrency
+    return max(1, os.cpu_count() or 1)
+
+
+def scan_repository_symbols(
+    repo_root: Path,
+    index_config: IndexConfig,
+    limits: SecurityLimits,
+    adapters: AdapterRegistry,
+    *,
+    concurrency: int | None = None,
+    cache_path: Path | None = None,
+    reuse_cache: bool = True,
+    skip_tests: bool = False,
+    go_target: tuple[str, str] | None = None,
+    kinds: tuple[str, ...] | None = None,
+    max_file_size: int | None = None,
+    profile: dict[str, object] | None = None,
+    diagnostics: list[Diagnostic] | None = None,
+    skipped: list[SkippedFile] | None = None,
+    progress: Callable[[int, int], None] | None = None,
+    redactor: Redactor | None = None,
+) -> Iterator[FileSymbols]:
+    """Yield outline symbols per discovered file in sorted path order.
+
+    Files are parsed by a bounded pool of spawned worker processes, so
+    CPU-bound adapters run in parallel; results are yielded in path order
+    regardless of completion order, as soon as each is available. With one
+    worker, when fewer than 32 files miss the cache, and for files served from
+    the cache, scanning stays in this process. adapters, limits, and redactor
+    are pickled to the workers. A file whose worker raises is reported as an
+    adapter failure; when a worker dies, the file awaited at that point is
+    reported the same way and the remaining files are parsed in this process.
+    Policy-blocked files, unreadable files, and adapter failures are skipped
+    and counted without affecting other files.
+
+    When cache_path is set, files whose content hash and selected adapter match
+    the cached entry reuse the cached symbols instead of being re-parsed. The
+    cache is rewritten with the current file set once the scan is fully
+    consumed, which drops entries for deleted files. With reuse_cache False
+    every file is re-parsed and the cache is still rewritten.
+
+    With skip_tests, groups whose symbols are flagged `is_test` are parsed and
+    cached as usual but not yielded. With go_target as (goos, goarch), groups
+    whose Go build constraints exclude that target are skipped the same way.
+    With kinds, a tuple of kind group names, adapters with an `outline_kinds`
+    method are given the allowlist and skip work only other kinds need; their
+    cache entries record it and are reused only by scans with the same
+    allowlist, while whole-file entries serve any allowlist. Groups are still
+    yielded with every symbol, so callers link packages and resolve references
+    before narrowing them with `select_kinds`.
+
+    Files larger than max_file_size bytes, or than limits.max_file_bytes when
+    that is smaller or max_file_size is None, are never read. Each is counted,
+    appended to skipped when it is a list, and reported as a whole-file
+    diagnostic. Denylisted files stay blocked without a report.
+
+    When diagnostics is a list, it receives the parse diagnostics of every
+    yielded group and one entry per adapter failure or oversized file, in
+    path order, as the scan is consumed.
+
+    When progress is set, it is called with (done, total) file counts once
+    files are discovered and again as each discovered file is processed,
+    whether it is yielded, skipped, or failed.
+
+    With redactor set, secrets are redacted from each file's symbols right
+    after parsing, before they are cached or yielded; cache entries written
+    under another redactor are not reused.
+    """
+    redaction = redactor.fingerprint if redactor is not None else None
+    previous = (
+        load_symbol_cache(cache_path, redaction=redaction)
+        if cache_path is not None and reuse_cache
+        else {}
+    )
+    records = discover_files(
+        repo_root,
+        index_config,
+        previous_records={path: entry.record for path, entry in previous.items()},
+    )
+    workers = resolve_scan_concurrency(concurrency)
+    allowed_kinds = kind_allowlist(kinds) if kinds is not None else None
+    kinds_key = tuple(sorted(allowed_kinds)) if allowed_kinds is not None else None
+    size_limit = min(max_file_size or limits.max_file_bytes, limits.max_file_bytes)
+    counters = {
+        "files_discovered": len(records),
+        "files_blocked": 0,
+        "files_failed": 0,
+        "cache_hits": 0,
+        "files_skipped_tests": 0,
+        "files_skipped_constraints": 0,
+        "files_skipped_size": 0,
+    }
+    fresh_entries: list[CachedFileSymbols] = []
+    processed = 0
+    if progress is not None:
+        progress(processed, len(records))
+
+    def cached_entry(record: FileRecord) -> CachedFileSymbols | None:
+        entry = previous.get(record.path)
+        if entry is None or entry.record.content_hash != record.content_hash:
+            return None
+        if entry.kinds is not None and entry.kinds != kinds_key:
+            return None
+        return entry
+
+    uncached = sum(1 for record in records if cached_entry(record) is None)
+    with ExitStack() as stack:
+        executor: ProcessPoolExecutor | None = None
+        if workers > 1 and uncached >= _POOL_MIN_UNCACHED_FILES:
+            executor = stack.enter_context(
+                ProcessPoolExecutor(max_workers=workers, mp_context=get_context("spawn"))
+            )
+        pending: deque[tuple[FileRecord, CachedFileSymbols | None, Future[_ScanOutcome]]]
+        pending = deque()
+        record_iter = iter(records)
+        window = workers * _IN_FLIGHT_PER_WORKER
+
+        def scan_one(record: FileRecord, cached: CachedFileSymbols | None) -> Future[_ScanOutcome]:
+            args = (
+                repo_root, record, limits, adapters, size_limit, cached, redactor, allowed_kinds
+            )
+            if executor is not None and cached is None:
+                try:
+                    return executor.submit(_scan_file, *args)
+                except BrokenProcessPool:
+                    # The pool broke before its failed future was awaited; scan inline.
+                    pass
+            future: Future[_ScanOutcome] = Future()
+            try:
+                future.set_result(_scan_file(*args))
+            except Exception as error:
+                future.set_exception(error)
+            return future
+
+        def submit(record: FileRecord) -> None:
+            cached = cached_entry(record)
+            pending.append((record, cached, scan_one(record, cached)))
+
+        for record in record_iter:
+            submit(record)
+            if len(pending) >= window:
+                break
+        while pending:
+            record, cached, future = pending.popleft()
+            try:
+                outcome = future.result()
+            except BrokenProcessPool as error:
+                outcome = _worker_failure(adapters, record, error)
+                if executor is not None:
+                    executor.shutdown(wait=False, cancel_futures=True)
+                    executor = None
+                    retried = [
+                        (item, entry, done if _succeeded(done) else scan_one(item, entry))
+                        for item, entry, done in pending
+                    ]
+                    pending.clear()
+                    pending.extend(retried)
+            except Exception as error:
+                outcome = _worker_failure(adapters, record, error)
+            next_record = next(record_iter, None)
+            if next_record is not None:
+                submit(next_record)
+            processed += 1
+            if progress is not None:
+                progress(processed, len(records))
+            if isinstance(outcome, str):
+                counters[outcome] += 1
+                continue
+            if isinstance(outcome, Diagnostic):
+                counters["files_failed"] += 1
+                if diagnostics is not None:
+                    diagnostics.append(outcome)
+                continue
+            if isinstance(outcome, SkippedFile):
+                counters["files_skipped_size"] += 1
+                if skipped is not None:
+                    skipped.append(outcome)
+                if diagnostics is not None:
+                    diagnostics.append(
+                        Diagnostic(
+                            path=outcome.path,
+                            line=None,
+                            message=(
+                                f"file skipped: {outcome.size} bytes exceeds "
+                                f"max_file_size {size_limit}"
+                            ),
+                        )
+                    )
+                continue
+            if outcome is cached:
+                counters["cache_hits"] += 1
+            fresh_entries.append(replace(outcome, record=record))
+            group = outcome.group
+            if skip_tests and any(symbol.is_test for symbol in group.symbols):
+                counters["files_skipped_tests"] += 1
+                continue
+            if go_target is not None and not _matches_go_target(group, go_target):
+                counters["files_skipped_constraints"] += 1
+                continue
+            if diagnostics is not None:
+                diagnostics.extend(
+                    Diagnostic(path=group.path, line=item.line, message=item.message)
+                    for item in group.diagnostics
+                )
+            yield group
+
+    if cache_path is not None:
+        write_symbol_cache(cache_path, fresh_entries, redaction=redaction)
+    if profile is not None:
+        profile.update(counters)
+        profile["concurrency"] = workers
+
+
+def _succeeded(future: Future[_ScanOutcome]) -> bool:
+    return future.done() and not future.cancelled() and future.exception() is None
+
+
+def _worker_failure(adapters: AdapterRegistry, record: FileRecord, error: Exception) -> Diagnostic:
+    try:
+        name = adapters.select(record.path).name
+    except Exception:
+        name = "scan"
+    return Diagnostic(
+        path=record.path,
+        line=None,
+        message=f"{name} adapter failed: {type(error).__name__}",
+    )
+
+
+def _matches_go_target(group: FileSymbols, go_target: tuple[str, str]) -> bool:
+    if not group.symbols or not group.symbols[0].build_constraints:
+        return True
+    goos, goarch = go_target
+    return go_constraints_match(group.symbols[0].build_constraints, goos, goarch)
+
+
+def _scan_file(
+    repo_root: Path,
+    record: FileRecord,
+    limits: SecurityLimits,
+    adapters: AdapterRegistry,
+    size_limit: int,
+    cached: CachedFileSymbols | None = None,
+    redactor: Redactor | None = None,
+    kinds: frozenset[str] | None = None,
+) -> _ScanOutcome:
+    candidate = repo_root / record.path
+    if record.size > size_limit and not is_denylisted(repo_root, candidate):
+        return SkippedFile(path=record.path, size=record.size)
+    try:
+        enforce_file_access_policy(
+            repo_root=repo_root,
+            resolved_path=candidate,
+            limits=limits,
+        )
+    except PolicyBlockedError:
+        return "files_blocked"
+    try:
+        adapter = adapters.select(record.path)
+        if cached is not None and cached.group.language == adapter.name:
+            return cached
+        text = candidate.read_text(encoding="utf-8", errors="replace")
+    except (LookupError, OSError):
+        return "files_failed"
+    outline_kinds = getattr(adapter, "outline_kinds", None) if kinds is not None else None
+    try:
+        if outline_kinds is not None:
+            outlined = outline_kinds(record.path, text, kinds)
+        else:
+            outlined = adapter.outline(record.path, text)
+        symbols = normalize_and_sort_symbols(outlined)
+        imports = adapter.imports(record.path, text)
+        found = adapter.diagnostics(record.path, text)
+    except Exception as error:
+        return Diagnostic(
+            path=record.path,
+            line=None,
+            message=f"{adapter.name} adapter failed: {type(error).__name__}",
+        )
+    if redactor is not None:
+        symbols = redact_symbols(symbols, redactor)
+    group = FileSymbols(
+        path=record.path,
+        language=adapter.name,
+        symbols=tuple(symbols),
+        imports=tuple(imports) if imports is not None else None,
+        line_count=len(text.splitlines()),
+        diagnostics=tuple(normalize_and_sort_diagnostics(found or [])),
+    )
+    partial = tuple(sorted(kinds)) if outline_kinds is not None and kinds is not None else None
+    return CachedFileSymbols(record=record, group=group, kinds=partial)
@@ -0,0 +1,130 @@
+"""JSON Schema of the `json` symbol export document, generated from the models."""
+
+from __future__ import annotations
+
+import json
+import types
+from dataclasses import fields, is_dataclass
+from typing import get_args, get_origin, get_type_hints
+
+from repo_mcp.adapters.base import FileImport, OutlineSymbol
+from repo_mcp.symbols.export import EXPORT_VERSION
+from repo_mcp.symbols.models import (
+    Diagnostic,
+    FileSymbols,
+    PartialType,
+    PartialTypeMember,
+    SkippedFile,
+)
+
+JSON_SCHEMA_DIALECT = "https://json-schema.org/draft/2020-12/schema"
+_DEF_NAMES: dict[type, str] = {
+    Diagnostic: "diagnostic",
+    FileImport: "import",
+    FileSymbols: "file",
+    OutlineSymbol: "symbol",
+    PartialType: "partial_type",
+    PartialTypeMember: "partial_type_member",
+    SkippedFile: "skipped_file",
+}
+# Nullable on the model, but always filled by the time a symbol is exported.
+_NON_NULL_SYMBOL_FIELDS = frozenset({"id", "qualified_name"})
+# file_symbols_payload writes these and leaves out scan-only fields.
+_FILE_FIELDS = ("path", "language", "symbols", "imports")
+_PRIMITIVE_TYPES: dict[object, str] = {bool: "boolean", int: "integer", str: "string"}
+
+
+def export_schema() -> dict[str, object]:
+    """Return the JSON Schema (draft 2020-12) of the `json` export document.
+
+    Properties are derived from the dataclass fields, so a field added to a
+    model is described without further changes. Every key the payload always
+    writes is `required`, and a field that may be null allows `"null"`. Only
+    `children`, written with group_by_type, is optional.
+    """
+    definitions = {
+        "diagnostic": _object_schema(Diagnostic),
+        "file": _object_schema(FileSymbols, only=_FILE_FIELDS),
+        "import": _object_schema(FileImport),
+        "partial_type": _object_schema(PartialType),
+        "partial_type_member": _object_schema(PartialTypeMember),
+        "skipped_file": _object_schema(SkippedFile),
+        "symbol": _object_schema(
+            OutlineSymbol,
+            non_null=_NON_NULL_SYMBOL_FIELDS,
+            optional={"children": {"type": "array", "items": {"$ref": "#/$defs/symbol"}}},
+        ),
+    }
+    return {
+        "$schema": JSON_SCHEMA_DIALECT,
+        "title": "repo-mcp symbol export",
+        "type": "object",
+        "properties": {
+            "export_version": {"const": EXPORT_VERSION},
+            "files": {"type": "array", "items": {"$ref": "#/$defs/file"}},
+            "diagnostics": {"type": "array", "items": {"$ref": "#/$defs/diagnostic"}},
+            "partial_types": {"type": "array", "items": {"$ref": "#/$defs/partial_type"}},
+            "skipped_files": {"type": "array", "items": {"$ref": "#/$defs/skipped_file"}},
+        },
+        "required": ["diagnostics", "export_version", "files", "partial_types", "skipped_files"],
+        "additionalProperties": False,
+        "$defs": definitions,
+    }
+
+
+def render_export_schema() -> str:
+    """Return the export schema as indented JSON with sorted keys."""
+    return json.dumps(export_schema(), sort_keys=True, indent=2) + "\n"
+
+
+def _object_schema(
+    model: type,
+    *,
+    only: tuple[str, ...] | None = None,
+    non_null: frozenset[str] = frozenset(),
+    optional: dict[str, object] | None = None,
+) -> dict[str, object]:
+    hints = get_type_hints(model)
+    names = only if only is not None else tuple(field.name for field in fields(model))
+    properties: dict[str, object] = {}
+    for name in names:
+        hint = hints[name]
+        if name in non_null:
+            hint = _strip_none(hint)
+        properties[name] = _type_schema(hint)
+    properties.update(optional or {})
+    return {
+        "type": "object",
+        "properties": properties,
+        "required": sorted(names),
+        "additionalProperties": False,
+    }
+
+
+def _type_schema(hint: object) -> dict[str, object]:
+    if hint in _PRIMITIVE_TYPES:
+        return {"type": _PRIMITIVE_TYPES[hint]}
+    if get_origin(hint) is types.UnionType:
+        inner = _type_schema(_strip_none(hint))
+        kind = inner.get("type")
+        if isinstance(kind, str):
+            return {**inner, "type": [kind, "null"]}
+        return {"anyOf": [inner, {"type": "null"}]}
+    if get_origin(hint) is tuple:
+        item = get_args(hint)[0]
+        if get_origin(item) is tuple and get_args(item) == (str, str):
+            # Key/value pair tuples such as `tags` are rendered as objects.
+            return {"type": "object", "additionalProperties": {"type": "string"}}
+        return {"type": "array", "items": _type_schema(item)}
+    if isinstance(hint, type) and is_dataclass(hint) and hint in _DEF_NAMES:
+        return {"$ref": f"#/$defs/{_DEF_NAMES[hint]}"}
+    raise TypeError(f"No JSON Schema mapping for field type {hint!r}")
+
+
+def _strip_none(hint: object) -> object:
+    if get_origin(hint) is not types.UnionType:
+        return hint
+    remaining = [arg for arg in get_args(hint) if arg is not type(None)]
+    if len(remaining) != 1:
+        raise TypeError(f"No JSON Schema mapping for field type {hint!r}")
+    return remaining[0]
@@ -0,0 +1,131 @@
+"""Deterministic ranked search over symbol names, signatures, and doc comments."""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Iterable
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.symbols.models import FileSymbols, SymbolSearchHit
+
+SEARCH_FORMATS = ("json", "markdown")
+SEARCH_FIELD_WEIGHTS: tuple[tuple[str, int], ...] = (("name", 4), ("signature", 2), ("doc", 1))
+TOKEN_MATCH_POINTS = 2
+SUBSTRING_MATCH_POINTS = 1
+
+_WORD_RE = re.compile(r"[A-Za-z0-9]+")
+_WORD_PART_RE = re.compile(r"[A-Z]+(?![a-z])|[A-Z]?[a-z]+|[0-9]+")
+
+_Posting = tuple[int, str]
+
+
+def search_terms(text: str) -> list[str]:
+    """Return the lowercased index terms of text.
+
+    Every alphanumeric word is a term, and so is each camelCase, snake_case,
+    or digit part of it, so `MaxRetries` yields `maxretries`, `max`, and
+    `retries`. Terms keep first-occurrence order without repeats.
+    """
+    terms: list[str] = []
+    seen: set[str] = set()
+    for word in _WORD_RE.findall(text):
+        for term in (word, *_WORD_PART_RE.findall(word)):
+            lowered = term.lower()
+            if lowered not in seen:
+                seen.add(lowered)
+                terms.append(lowered)
+    return terms
+
+
+class SymbolSearchIndex:
+    """In-memory inverted index from terms to the symbol fields containing them."""
+
+    def __init__(self, groups: Iterable[FileSymbols]) -> None:
+        self._entries: list[tuple[str, OutlineSymbol]] = []
+        self._postings: dict[str, set[_Posting]] = {}
+        for group in groups:
+            for symbol in group.symbols:
+                entry_id = len(self._entries)
+                self._entries.append((group.path, symbol))
+                for field, _ in SEARCH_FIELD_WEIGHTS:
+                    for term in search_terms(getattr(symbol, field) or ""):
+                        self._postings.setdefault(term, set()).add((entry_id, field))
+        self._vocabulary = sorted(self._postings)
+
+    def search(self, query: str) -> list[SymbolSearchHit]:
+        """Return every symbol matching a query term, best match first.
+
+        A query term scores `TOKEN_MATCH_POINTS` times the field weight when a
+        field contains it as a term, otherwise `SUBSTRING_MATCH_POINTS` times
+        the weight when it is a substring of one of the field's terms. A name
+        equal to the whole query, ignoring case and separators, earns the name
+        weight once more. Ties break by path, start line, and name.
+        """
+        weights = dict(SEARCH_FIELD_WEIGHTS)
+        scores: dict[int, int] = {}
+        matched: dict[int, set[str]] = {}
+        for term in search_terms(query):
+            best: dict[_Posting, int] = {}
+            for posting in self._postings.get(term, ()):
+                best[posting] = TOKEN_MATCH_POINTS
+            for candidate in self._vocabulary:
+                if term in candidate and candidate != term:
+                    for posting in self._postings[candidate]:
+                        best.setdefault(posting, SUBSTRING_MATCH_POINTS)
+            for (entry_id, field), points in best.items():
+                scores[entry_id] = scores.get(entry_id, 0) + points * weights[field]
+                matched.setdefault(entry_id, set()).add(field)
+        compact_query = "".join(_WORD_RE.findall(query)).lower()
+        hits: list[SymbolSearchHit] = []
+        for entry_id, score in scores.items():
+            path, symbol = self._entries[entry_id]
+            short_name = symbol.name.rsplit(".", 1)[-1]
+            if compact_query and "".join(_WORD_RE.findall(short_name)).lower() == compact_query:
+                score += weights["name"]
+            hits.append(
+                SymbolSearchHit(
+                    path=path,
+                    symbol=symbol,
+                    score=score,
+                    matched_fields=tuple(
+                        field for field, _ in SEARCH_FIELD_WEIGHTS if field in matched[entry_id]
+                    ),
+                )
+            )
+        hits.sort(key=lambda hit: (-hit.score, hit.path, hit.symbol.start_line, hit.symbol.name))
+        return hits
+
+
+def symbol_search_hit_payload(hit: SymbolSearchHit) -> dict[str, object]:
+    """Return a JSON-ready payload for one search hit with its file location."""
+    return {
+        "path": hit.path,
+        "kind": hit.symbol.kind,
+        "name": hit.symbol.name,
+        "signature": hit.symbol.signature,
+        "start_line": hit.symbol.start_line,
+        "start_col": hit.symbol.start_col,
+        "end_line": hit.symbol.end_line,
+        "score": hit.score,
+        "matched_fields": list(hit.matched_fields),
+    }
+
+
+def render_search_markdown(query: str, hits: Iterable[SymbolSearchHit]) -> str:
+    """Render ranked hits as a numbered Markdown list with `path:line` locations."""
+    lines = [f"# Symbol search: `{query}`", ""]
+    rank = 0
+    for hit in hits:
+        rank += 1
+        symbol = hit.symbol
+        lines.append(
+            f"{rank}. `{symbol.name}` ({symbol.kind}) - `{hit.path}:{symbol.start_line}`"
+            f" - score {hit.score}"
+        )
+        if symbol.signature:
+            lines.append(f"   - signature: `{symbol.signature}`")
+        if symbol.doc:
+            lines.append(f"   - {symbol.doc.strip().splitlines()[0]}")
+    if rank == 0:
+        lines.append("No matching symbols.")
+    return "\n".join(lines) + "\n"
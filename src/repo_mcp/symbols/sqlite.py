@@ -0,0 +1,217 @@
+"""SQLite export of symbols, their relationships, and imports for ad-hoc SQL queries."""
+
+from __future__ import annotations
+
+import sqlite3
+from collections.abc import Sequence
+from pathlib import Path, PurePosixPath
+
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.symbols.models import FileSymbols
+
+SQLITE_SCHEMA_VERSION = 1
+_SCHEMA = (
+    """
+    CREATE TABLE IF NOT EXISTS files (
+        path TEXT PRIMARY KEY,
+        language TEXT NOT NULL
+    )
+    """,
+    """
+    CREATE TABLE IF NOT EXISTS symbols (
+        id TEXT NOT NULL,
+        occurrence INTEGER NOT NULL,
+        name TEXT NOT NULL,
+        qualified_name TEXT NOT NULL,
+        kind TEXT NOT NULL,
+        visibility TEXT,
+        file TEXT NOT NULL,
+        start_line INTEGER NOT NULL,
+        end_line INTEGER NOT NULL,
+        package TEXT,
+        parent_id TEXT,
+        signature TEXT,
+        canonical_signature TEXT,
+        PRIMARY KEY (id, occurrence)
+    )
+    """,
+    """
+    CREATE TABLE IF NOT EXISTS edges (
+        source_id TEXT NOT NULL,
+        kind TEXT NOT NULL,
+        target TEXT NOT NULL,
+        target_id TEXT
+    )
+    """,
+    """
+    CREATE TABLE IF NOT EXISTS imports (
+        file TEXT NOT NULL,
+        path TEXT NOT NULL,
+        name TEXT,
+        alias TEXT,
+        line INTEGER NOT NULL,
+        used INTEGER
+    )
+    """,
+    "CREATE INDEX IF NOT EXISTS symbols_file ON symbols (file, start_line)",
+    "CREATE INDEX IF NOT EXISTS symbols_qualified_name ON symbols (qualified_name)",
+    "CREATE INDEX IF NOT EXISTS edges_source ON edges (source_id, kind)",
+    "CREATE INDEX IF NOT EXISTS edges_target ON edges (target_id, kind)",
+    "CREATE INDEX IF NOT EXISTS imports_file ON imports (file, line)",
+)
+_SYMBOL_COLUMNS = (
+    "id",
+    "occurrence",
+    "name",
+    "qualified_name",
+    "kind",
+    "visibility",
+    "file",
+    "start_line",
+    "end_line",
+    "package",
+    "parent_id",
+    "signature",
+    "canonical_signature",
+)
+
+
+def write_sqlite_export(groups: Sequence[FileSymbols], destination: Path) -> None:
+    """Upsert file symbol groups into the SQLite database at destination.
+
+    The database is created when missing. Symbols are keyed by `(id,
+    occurrence)`, where occurrence numbers the symbols sharing one ID in path
+    then outline order, so re-running updates rows in place; rows of symbols
+    no longer exported are deleted. `files`, `imports`, and `edges` are
+    rewritten. Everything is written in one transaction. SQLite errors,
+    including a destination that is not a database of this schema version,
+    are raised as OSError.
+    """
+    try:
+        connection = sqlite3.connect(destination)
+    except sqlite3.Error as error:
+        raise OSError(f"cannot open SQLite database: {error}") from error
+    try:
+        version = connection.execute("PRAGMA user_version").fetchone()[0]
+        if version not in {0, SQLITE_SCHEMA_VERSION}:
+            raise OSError(f"unsupported SQLite export schema version {version}")
+        for statement in _SCHEMA:
+            connection.execute(statement)
+        connection.execute(f"PRAGMA user_version = {SQLITE_SCHEMA_VERSION}")
+        with connection:
+            _write_rows(connection, groups)
+    except sqlite3.Error as error:
+        raise OSError(f"SQLite export failed: {error}") from error
+    finally:
+        connection.close()
+
+
+def _write_rows(connection: sqlite3.Connection, groups: Sequence[FileSymbols]) -> None:
+    ids_by_name: dict[str, list[tuple[str, str]]] = {}
+    known_ids: set[str] = set()
+    for group in groups:
+        directory = PurePosixPath(group.path).parent.as_posix()
+        for symbol in group.symbols:
+            ids_by_name.setdefault(symbol.name, []).append((directory, _symbol_id(symbol)))
+            known_ids.add(_symbol_id(symbol))
+
+    def resolve(name: str, directory: str) -> str | None:
+        if name in known_ids:
+            return name
+        candidates = ids_by_name.get(name, ())
+        for candidate_directory, symbol_id in candidates:
+            if candidate_directory == directory:
+                return symbol_id
+        return candidates[0][1] if candidates else None
+
+    symbol_rows: list[tuple[object, ...]] = []
+    edge_rows: list[tuple[str, str, str, str | None]] = []
+    import_rows: list[tuple[object, ...]] = []
+    occurrences: dict[str, int] = {}
+    for group in groups:
+        directory = PurePosixPath(group.path).parent.as_posix()
+        local_ids = {symbol.name: _symbol_id(symbol) for symbol in reversed(group.symbols)}
+        for symbol in group.symbols:
+            symbol_id = _symbol_id(symbol)
+            occurrence = occurrences.get(symbol_id, 0)
+            occurrences[symbol_id] = occurrence + 1
+            parent_id = None
+            if symbol.parent_symbol is not None:
+                parent_id = local_ids.get(symbol.parent_symbol) or resolve(
+                    symbol.parent_symbol, directory
+                )
+            if parent_id is not None:
+                edge_rows.append((parent_id, "child", _qualified_name(symbol), symbol_id))
+            symbol_rows.append(
+                (
+                    symbol_id,
+                    occurrence,
+                    symbol.name,
+                    _qualified_name(symbol),
+                    symbol.kind,
+                    symbol.visibility,
+                    group.path,
+                    symbol.start_line,
+                    symbol.end_line,
+                    symbol.package,
+                    parent_id,
+                    symbol.signature,
+                    symbol.canonical_signature,
+                )
+            )
+            for kind, targets in (
+                ("calls", symbol.calls),
+                ("implements", symbol.implements),
+                ("references", symbol.references),
+            ):
+                edge_rows.extend(
+                    (symbol_id, kind, target, resolve(target, directory))
+                    for target in targets or ()
+                )
+        import_rows.extend(
+            (
+                group.path,
+                record.path,
+                record.name,
+                record.alias,
+                record.line,
+                None if record.used is None else int(record.used),
+            )
+            for record in group.imports or ()
+        )
+
+    placeholders = ", ".join("?" for _ in _SYMBOL_COLUMNS)
+    updates = ", ".join(f"{column} = excluded.{column}" for column in _SYMBOL_COLUMNS[2:])
+    connection.executemany(
+        f"INSERT INTO symbols ({', '.join(_SYMBOL_COLUMNS)}) VALUES ({placeholders}) "
+        f"ON CONFLICT (id, occurrence) DO UPDATE SET {updates}",
+        symbol_rows,
+    )
+    current = {(row[0], row[1]) for row in symbol_rows}
+    stale = [
+        key
+        for key in connection.execute("SELECT id, occurrence FROM symbols ORDER BY id, occurrence")
+        if key not in current
+    ]
+    connection.executemany("DELETE FROM symbols WHERE id = ? AND occurrence = ?", stale)
+    for table in ("files", "edges", "imports"):
+        connection.execute(f"DELETE FROM {table}")
+    connection.executemany(
+        "INSERT INTO files (path, language) VALUES (?, ?)",
+        [(group.path, group.language) for group in groups],
+    )
+    connection.executemany(
+        "INSERT INTO edges (source_id, kind, target, target_id) VALUES (?, ?, ?, ?)", edge_rows
+    )
+    connection.executemany(
+        "INSERT INTO imports (file, path, name, alias, line, used) VALUES (?, ?, ?, ?, ?, ?)",
+        import_rows,
+    )
+
+
+def _symbol_id(symbol: OutlineSymbol) -> str:
+    return symbol.id or _qualified_name(symbol)
+
+
+def _qualified_name(symbol: OutlineSymbol) -> str:
+    return symbol.qualified_name or symbol.name
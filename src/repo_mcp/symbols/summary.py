@@ -0,0 +1,144 @@
+"""Deterministic repository profile aggregated from a symbol scan."""
+
+from __future__ import annotations
+
+from collections import Counter
+from collections.abc import Iterable
+from dataclasses import asdict
+
+from repo_mcp.symbols.dot import file_package
+from repo_mcp.symbols.models import (
+    FileLineCount,
+    FileSymbols,
+    LanguageSummary,
+    PackageSummary,
+    RepoSummary,
+)
+
+SUMMARY_FORMATS = ("json", "markdown")
+SUMMARY_LARGEST_FILES = 10
+
+
+def summarize_symbols(
+    groups: Iterable[FileSymbols], *, largest_files: int = SUMMARY_LARGEST_FILES
+) -> RepoSummary:
+    """Collapse scanned file groups into language, kind, and package counts.
+
+    Packages come from each file's reported symbol package, else the file's
+    directory. The largest files are ranked by line count, ties broken by path.
+    """
+    language_totals: dict[str, list[int]] = {}
+    package_files: Counter[str] = Counter()
+    package_kinds: dict[str, Counter[str]] = {}
+    package_visibility: dict[str, Counter[str]] = {}
+    kinds: Counter[str] = Counter()
+    visibility: Counter[str] = Counter()
+    sizes: list[FileLineCount] = []
+    for group in groups:
+        lines = group.line_count or 0
+        totals = language_totals.setdefault(group.language, [0, 0, 0])
+        totals[0] += 1
+        totals[1] += lines
+        totals[2] += len(group.symbols)
+        sizes.append(FileLineCount(path=group.path, language=group.language, lines=lines))
+        package = file_package(group)
+        package_files[package] += 1
+        group_kinds = package_kinds.setdefault(package, Counter())
+        group_visibility = package_visibility.setdefault(package, Counter())
+        for symbol in group.symbols:
+            kinds[symbol.kind] += 1
+            group_kinds[symbol.kind] += 1
+            if symbol.visibility is not None:
+                visibility[symbol.visibility] += 1
+                group_visibility[symbol.visibility] += 1
+    packages = tuple(
+        PackageSummary(
+            package=package,
+            files=package_files[package],
+            symbols=sum(package_kinds[package].values()),
+            exported=package_visibility[package]["public"],
+            unexported=package_visibility[package]["private"],
+            kinds=tuple(sorted(package_kinds[package].items())),
+        )
+        for package in sorted(package_files)
+    )
+    sizes.sort(key=lambda item: (-item.lines, item.path))
+    return RepoSummary(
+        files=len(sizes),
+        lines=sum(item.lines for item in sizes),
+        symbols=sum(kinds.values()),
+        exported=visibility["public"],
+        unexported=visibility["private"],
+        languages=tuple(
+            LanguageSummary(language=language, files=files, lines=lines, symbols=symbols)
+            for language, (files, lines, symbols) in sorted(language_totals.items())
+        ),
+        kinds=tuple(sorted(kinds.items())),
+        packages=packages,
+        largest_files=tuple(sizes[:largest_files]),
+    )
+
+
+def repo_summary_payload(summary: RepoSummary) -> dict[str, object]:
+    """Return a JSON-ready payload; kind counts are rendered as objects."""
+    return {
+        "files": summary.files,
+        "lines": summary.lines,
+        "symbols": summary.symbols,
+        "exported": summary.exported,
+        "unexported": summary.unexported,
+        "languages": [asdict(item) for item in summary.languages],
+        "kinds": dict(summary.kinds),
+        "packages": [{**asdict(item), "kinds": dict(item.kinds)} for item in summary.packages],
+        "largest_files": [asdict(item) for item in summary.largest_files],
+    }
+
+
+def render_summary_markdown(summary: RepoSummary) -> str:
+    """Render the summary as Markdown totals followed by one table per breakdown."""
+    lines = [
+        "# Repository Summary",
+        "",
+        f"- Files: {summary.files}",
+        f"- Lines: {summary.lines}",
+        f"- Symbols: {summary.symbols} ({summary.exported} exported, "
+        f"{summary.unexported} unexported)",
+        "",
+        "## Languages",
+        "",
+        "| Language | Files | Lines | Symbols |",
+        "| --- | ---: | ---: | ---: |",
+    ]
+    for language in summary.languages:
+        lines.append(
+            f"| {_cell(language.language)} | {language.files} | {language.lines} "
+            f"| {language.symbols} |"
+        )
+    lines.extend(["", "## Symbol Kinds", "", "| Kind | Count |", "| --- | ---: |"])
+    for kind, count in summary.kinds:
+        lines.append(f"| {_cell(kind)} | {count} |")
+    lines.extend(
+        [
+            "",
+            "## Packages",
+            "",
+            "| Package | Files | Symbols | Exported | Unexported | Kinds |",
+            "| --- | ---: | ---: | ---: | ---: | --- |",
+        ]
+    )
+    for package in summary.packages:
+        kinds = ", ".join(f"{kind} {count}" for kind, count in package.kinds)
+        lines.append(
+            f"| {_cell(package.package)} | {package.files} | {package.symbols} "
+            f"| {package.exported} | {package.unexported} | {_cell(kinds)} |"
+        )
+    lines.extend(
+        ["", "## Largest Files", "", "| File | Language | Lines |", "| --- | --- | ---: |"]
+    )
+    for item in summary.largest_files:
+        lines.append(f"| {_cell(item.path)} | {_cell(item.language)} | {item.lines} |")
+    return "\n".join(lines) + "\n"
+
+
+def _cell(text: str) -> str:
+    return text.replace("|", "\\|")
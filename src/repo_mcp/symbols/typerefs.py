@@ -0,0 +1,50 @@
+"""Resolution of symbol type references to the IDs of their definitions."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+from dataclasses import replace
+from pathlib import PurePosixPath
+
+from repo_mcp.symbols.grouping import TYPE_KINDS
+from repo_mcp.symbols.models import FileSymbols
+
+
+def resolve_type_references(groups: Iterable[FileSymbols]) -> list[FileSymbols]:
+    """Replace each resolvable `references` entry with the defining symbol's ID.
+
+    A reference resolves when a type symbol declared in the same package, that
+    is a file in the same directory reporting the same `package`, has that
+    qualified name; the first such declaration in path order wins. Other
+    references, such as standard library or external types, are kept as
+    qualified names. Groups are returned in input order.
+    """
+    materialized = list(groups)
+    definitions: dict[tuple[str, str], dict[str, str]] = {}
+    for group in sorted(materialized, key=lambda item: item.path):
+        directory = PurePosixPath(group.path).parent.as_posix()
+        for symbol in group.symbols:
+            if symbol.kind not in TYPE_KINDS or symbol.package is None or symbol.id is None:
+                continue
+            names = definitions.setdefault((directory, symbol.package), {})
+            names.setdefault(symbol.qualified_name or symbol.name, symbol.id)
+    resolved: list[FileSymbols] = []
+    for group in materialized:
+        directory = PurePosixPath(group.path).parent.as_posix()
+        if not any(symbol.references for symbol in group.symbols):
+            resolved.append(group)
+            continue
+        symbols = tuple(
+            replace(
+                symbol,
+                references=tuple(
+                    definitions.get((directory, symbol.package or ""), {}).get(name, name)
+                    for name in symbol.references
+                ),
+            )
+            if symbol.references
+            else symbol
+            for symbol in group.symbols
+        )
+        resolved.append(replace(group, symbols=symbols))
+    return resolved
@@ -0,0 +1,79 @@
+"""Polling change detection that debounces bursts of file writes."""
+
+from __future__ import annotations
+
+import time
+from collections.abc import Callable, Iterator
+from pathlib import Path
+
+from repo_mcp.config import IndexConfig
+from repo_mcp.index.discovery import discover_files, record_map
+from repo_mcp.index.models import FileRecord
+from repo_mcp.symbols.models import WatchBatch
+
+DEFAULT_WATCH_POLL_SECONDS = 0.2
+
+Snapshot = dict[str, FileRecord]
+
+
+def repository_snapshot(
+    repo_root: Path,
+    index_config: IndexConfig,
+    previous: Snapshot | None = None,
+) -> Snapshot:
+    """Return discovered file records by path, re-hashing only files whose stat changed."""
+    return record_map(discover_files(repo_root, index_config, previous_records=previous))
+
+
+def watch_changes(
+    snapshot: Callable[[Snapshot | None], Snapshot],
+    *,
+    debounce_seconds: float,
+    poll_seconds: float = DEFAULT_WATCH_POLL_SECONDS,
+    should_stop: Callable[[], bool] = lambda: False,
+    sleep: Callable[[float], None] = time.sleep,
+    clock: Callable[[], float] = time.monotonic,
+) -> Iterator[WatchBatch]:
+    """Poll snapshot and yield one batch per settled burst of content changes.
+
+    A burst settles once the snapshot has stayed identical for debounce_seconds,
+    so an editor writing a file several times in quick succession yields a
+    single batch. Files are compared by content hash, so touching a file
+    without changing it yields nothing. should_stop is checked before every
+    poll.
+    """
+    baseline = snapshot(None)
+    while not should_stop():
+        sleep(poll_seconds)
+        latest = snapshot(baseline)
+        if _same_content(latest, baseline):
+            continue
+        settled_since = clock()
+        while not should_stop():
+            sleep(poll_seconds)
+            current = snapshot(latest)
+            if not _same_content(current, latest):
+                latest = current
+                settled_since = clock()
+            elif clock() - settled_since >= debounce_seconds:
+                break
+        batch = _batch(baseline, latest)
+        baseline = latest
+        if batch.changed or batch.removed:
+            yield batch
+
+
+def _same_content(left: Snapshot, right: Snapshot) -> bool:
+    if left.keys() != right.keys():
+        return False
+    return all(record.content_hash == right[path].content_hash for path, record in left.items())
+
+
+def _batch(previous: Snapshot, current: Snapshot) -> WatchBatch:
+    changed = sorted(
+        path
+        for path, record in current.items()
+        if path not in previous or previous[path].content_hash != record.content_hash
+    )
+    removed = sorted(previous.keys() - current.keys())
+    return WatchBatch(changed=tuple(changed), removed=tuple(removed))
@@ -5,7 +5,7 @@ from __future__ import annotations
 from collections.abc import Callable
 from pathlib import Path
 
-from repo_mcp.config import ServerConfig
+from repo_mcp.config import GRAPH_LEVELS, MAX_SCAN_CONCURRENCY_CAP, ServerConfig
 from repo_mcp.index import (
     DEFAULT_CHUNK_LINES,
     DEFAULT_CHUNK_OVERLAP_LINES,
@@ -19,6 +19,14 @@ from repo_mcp.security import (
     enforce_open_line_limits,
     resolve_repo_path,
 )
+from repo_mcp.symbols import (
+    DIFF_FAIL_ON,
+    EXPORT_FORMATS,
+    QUERY_FORMATS,
+    SEARCH_FORMATS,
+    SUMMARY_FORMATS,
+)
+from repo_mcp.symbols.pack import MAX_CHARS_PER_TOKEN, MAX_PACK_TOKENS
 from repo_mcp.tools.registry import ToolDispatchError, ToolHandler, ToolMetadata, ToolRegistry
 from repo_mcp.tools.schemas import TOOL_SCHEMAS
 
@@ -41,10 +49,16 @@ def register_builtin_tools(
     refresh_index: Callable[[bool], dict[str, object]],
     read_index_status: Callable[[], IndexStatus],
     search_index: Callable[[str, int, str | None, str | None, str], list[dict[str, object]]],
-    outline_path: Callable[[str], dict[str, object]],
+    outline_path: Callable[[str, bool | None, bool | None, int | None], dict[str, object]],
     build_context_bundle: Callable[[dict[str, object]], dict[str, object]],
     resolve_references: Callable[[dict[str, object]], dict[str, object]],
     find_definition: Callable[[dict[str, object]], dict[str, object]],
+    export_symbols: Callable[[dict[str, object]], dict[str, object]],
+    pack_symbols: Callable[[dict[str, object]], dict[str, object]],
+    query_symbols: Callable[[dict[str, object]], dict[str, object]],
+    search_symbols: Callable[[dict[str, object]], dict[str, object]],
+    summary: Callable[[dict[str, object]], dict[str, object]],
+    diff_symbols: Callable[[dict[str, object]], dict[str, object]],
     config: ServerConfig,
     semantic_status: Callable[[], tuple[bool, str]],
 ) -> None:
@@ -89,6 +103,36 @@ def register_builtin_tools(
         _find_definition_handler(limits, find_definition),
         _meta("repo.find_definition"),
     )
+    registry.register(
+        "repo.export_symbols",
+        _export_symbols_handler(export_symbols),
+        _meta("repo.export_symbols"),
+    )
+    registry.register(
+        "repo.pack_symbols",
+        _pack_symbols_handler(pack_symbols),
+        _meta("repo.pack_symbols"),
+    )
+    registry.register(
+        "repo.query_symbols",
+        _query_symbols_handler(query_symbols),
+        _meta("repo.query_symbols"),
+    )
+    registry.register(
+        "repo.search_symbols",
+        _search_symbols_handler(limits, search_symbols),
+        _meta("repo.search_symbols"),
+    )
+    registry.register(
+        "repo.summary",
+        _summary_handler(summary),
+        _meta("repo.summary"),
+    )
+    registry.register(
+        "repo.diff_symbols",
+        _diff_symbols_handler(diff_symbols),
+        _meta("repo.diff_symbols"),
+    )
     registry.register(
         "repo.refresh_index",
         _refresh_index_handler(refresh_index),
@@ -149,7 +193,9 @@ def _list_files_handler(
     return handler
 
 
-def _outline_handler(outline_path: Callable[[str], dict[str, object]]) -> ToolHandler:
+def _outline_handler(
+    outline_path: Callable[[str, bool | None, bool | None, int | None], dict[str, object]],
+) -> ToolHandler:
     def handler(arguments: dict[str, object]) -> dict[str, object]:
         path_value = arguments.get("path")
         if not isinstance(path_value, str) or not path_value:
@@ -157,7 +203,26 @@ def _outline_handler(outline_path: Callable[[str], dict[str, object]]) -> ToolHa
                 code="INVALID_PARAMS",
                 message="repo.outline path must be a non-empty string.",
             )
-        return outline_path(path_value)
+        public_only_value = arguments.get("public_only")
+        if public_only_value is not None and not isinstance(public_only_value, bool):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.outline public_only must be a boolean.",
+            )
+        deprecated_only_value = arguments.get("deprecated_only")
+        if deprecated_only_value is not None and not isinstance(deprecated_only_value, bool):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.outline deprecated_only must be a boolean.",
+            )
+        _require_min_complexity("repo.outline", arguments)
+        min_complexity_value = arguments.get("min_complexity")
+        return outline_path(
+            path_value,
+            public_only_value,
+            deprecated_only_value,
+            min_complexity_value if isinstance(min_complexity_value, int) else None,
+        )
 
     return handler
 
@@ -333,6 +398,240 @@ def _build_context_bundle_handler(
     return handler
 
 
+def _require_skip_tests_bool(tool: str, arguments: dict[str, object]) -> None:
+    skip_tests = arguments.get("skip_tests")
+    if skip_tests is not None and not isinstance(skip_tests, bool):
+        raise ToolDispatchError(
+            code="INVALID_PARAMS",
+            message=f"{tool} skip_tests must be a boolean.",
+        )
+
+
+def _require_min_complexity(tool: str, arguments: dict[str, object]) -> None:
+    min_complexity = arguments.get("min_complexity")
+    if min_complexity is not None and (
+        not isinstance(min_complexity, int)
+        or isinstance(min_complexity, bool)
+        or min_complexity < 1
+    ):
+        raise ToolDispatchError(
+            code="INVALID_PARAMS",
+            message=f"{tool} min_complexity must be a positive integer.",
+        )
+
+
+def _export_symbols_handler(
+    export_symbols: Callable[[dict[str, object]], dict[str, object]],
+) -> ToolHandler:
+    def handler(arguments: dict[str, object]) -> dict[str, object]:
+        format_value = arguments.get("format")
+        if format_value is not None and (
+            not isinstance(format_value, str) or format_value not in EXPORT_FORMATS
+        ):
+            allowed = ", ".join(EXPORT_FORMATS)
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=f"repo.export_symbols format must be one of: {allowed}.",
+            )
+        concurrency_value = arguments.get("concurrency")
+        if concurrency_value is not None and (
+            not isinstance(concurrency_value, int)
+            or isinstance(concurrency_value, bool)
+            or not 1 <= concurrency_value <= MAX_SCAN_CONCURRENCY_CAP
+        ):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=(
+                    "repo.export_symbols concurrency must be an integer between 1 and "
+                    f"{MAX_SCAN_CONCURRENCY_CAP}."
+                ),
+            )
+        if not isinstance(arguments.get("force", False), bool):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.export_symbols force must be a boolean.",
+            )
+        if not isinstance(arguments.get("strict", False), bool):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.export_symbols strict must be a boolean.",
+            )
+        if not isinstance(arguments.get("group_by_type", False), bool):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.export_symbols group_by_type must be a boolean.",
+            )
+        graph_level = arguments.get("graph_level")
+        if graph_level is not None and (
+            not isinstance(graph_level, str) or graph_level not in GRAPH_LEVELS
+        ):
+            allowed = ", ".join(GRAPH_LEVELS)
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=f"repo.export_symbols graph_level must be one of: {allowed}.",
+            )
+        _require_skip_tests_bool("repo.export_symbols", arguments)
+        return export_symbols(arguments)
+
+    return handler
+
+
+def _pack_symbols_handler(
+    pack_symbols: Callable[[dict[str, object]], dict[str, object]],
+) -> ToolHandler:
+    def handler(arguments: dict[str, object]) -> dict[str, object]:
+        max_tokens = arguments.get("max_tokens")
+        if (
+            not isinstance(max_tokens, int)
+            or isinstance(max_tokens, bool)
+            or not 1 <= max_tokens <= MAX_PACK_TOKENS
+        ):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=(
+                    f"repo.pack_symbols max_tokens must be an integer between 1 and "
+                    f"{MAX_PACK_TOKENS}."
+                ),
+            )
+        chars_per_token = arguments.get("chars_per_token")
+        if chars_per_token is not None and (
+            not isinstance(chars_per_token, int)
+            or isinstance(chars_per_token, bool)
+            or not 1 <= chars_per_token <= MAX_CHARS_PER_TOKEN
+        ):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=(
+                    "repo.pack_symbols chars_per_token must be an integer between 1 and "
+                    f"{MAX_CHARS_PER_TOKEN}."
+                ),
+            )
+        _require_skip_tests_bool("repo.pack_symbols", arguments)
+        return pack_symbols(arguments)
+
+    return handler
+
+
+def _query_symbols_handler(
+    query_symbols: Callable[[dict[str, object]], dict[str, object]],
+) -> ToolHandler:
+    def handler(arguments: dict[str, object]) -> dict[str, object]:
+        for name in ("kind", "returns", "package"):
+            value = arguments.get(name)
+            if value is not None and (not isinstance(value, str) or not value.strip()):
+                raise ToolDispatchError(
+                    code="INVALID_PARAMS",
+                    message=f"repo.query_symbols {name} must be a non-empty string.",
+                )
+        visibility = arguments.get("visibility")
+        if visibility is not None and visibility not in {"public", "private"}:
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.query_symbols visibility must be one of: public, private.",
+            )
+        deprecated = arguments.get("deprecated")
+        if deprecated is not None and not isinstance(deprecated, bool):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.query_symbols deprecated must be a boolean.",
+            )
+        format_value = arguments.get("format", "json")
+        if not isinstance(format_value, str) or format_value not in QUERY_FORMATS:
+            allowed = ", ".join(QUERY_FORMATS)
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=f"repo.query_symbols format must be one of: {allowed}.",
+            )
+        _require_min_complexity("repo.query_symbols", arguments)
+        _require_skip_tests_bool("repo.query_symbols", arguments)
+        return query_symbols(arguments)
+
+    return handler
+
+
+def _search_symbols_handler(
+    limits: SecurityLimits,
+    search_symbols: Callable[[dict[str, object]], dict[str, object]],
+) -> ToolHandler:
+    def handler(arguments: dict[str, object]) -> dict[str, object]:
+        query = arguments.get("query")
+        if not isinstance(query, str) or not query.strip():
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.search_symbols query must be a non-empty string.",
+            )
+        limit = arguments.get("limit")
+        if limit is not None and (
+            not isinstance(limit, int)
+            or isinstance(limit, bool)
+            or not 1 <= limit <= limits.max_search_hits
+        ):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=(
+                    "repo.search_symbols limit must be an integer between 1 and "
+                    f"{limits.max_search_hits}."
+                ),
+            )
+        format_value = arguments.get("format", "json")
+        if not isinstance(format_value, str) or format_value not in SEARCH_FORMATS:
+            allowed = ", ".join(SEARCH_FORMATS)
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=f"repo.search_symbols format must be one of: {allowed}.",
+            )
+        _require_skip_tests_bool("repo.search_symbols", arguments)
+        return search_symbols(arguments)
+
+    return handler
+
+
+def _summary_handler(
+    summary: Callable[[dict[str, object]], dict[str, object]],
+) -> ToolHandler:
+    def handler(arguments: dict[str, object]) -> dict[str, object]:
+        format_value = arguments.get("format", "json")
+        if not isinstance(format_value, str) or format_value not in SUMMARY_FORMATS:
+            allowed = ", ".join(SUMMARY_FORMATS)
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=f"repo.summary format must be one of: {allowed}.",
+            )
+        _require_skip_tests_bool("repo.summary", arguments)
+        return summary(arguments)
+
+    return handler
+
+
+def _diff_symbols_handler(
+    diff_symbols: Callable[[dict[str, object]], dict[str, object]],
+) -> ToolHandler:
+    def handler(arguments: dict[str, object]) -> dict[str, object]:
+        old_value = arguments.get("old")
+        if not isinstance(old_value, str) or not old_value:
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.diff_symbols old must be a non-empty string.",
+            )
+        new_value = arguments.get("new")
+        if new_value is not None and (not isinstance(new_value, str) or not new_value):
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message="repo.diff_symbols new must be a non-empty string.",
+            )
+        fail_on = arguments.get("fail_on", "none")
+        if not isinstance(fail_on, str) or fail_on not in DIFF_FAIL_ON:
+            allowed = ", ".join(DIFF_FAIL_ON)
+            raise ToolDispatchError(
+                code="INVALID_PARAMS",
+                message=f"repo.diff_symbols fail_on must be one of: {allowed}.",
+            )
+        _require_skip_tests_bool("repo.diff_symbols", arguments)
+        return diff_symbols(arguments)
+
+    return handler
+
+
 def _refresh_index_handler(refresh_index: Callable[[bool], dict[str, object]]) -> ToolHandler:
     def handler(arguments: dict[str, object]) -> dict[str, object]:
         force_value = arguments.get("force", False)
@@ -78,6 +78,29 @@ TOOL_SCHEMAS: dict[str, dict[str, object]] = {
                     "type": "string",
                     "description": "Repository-relative file path.",
                 },
+                "public_only": {
+                    "type": "boolean",
+                    "description": (
+                        "Return only symbols whose visibility is 'public' (default: "
+                        "output.public_only config, else false). "
+                        "Symbols without visibility metadata are dropped."
+                    ),
+                },
+                "deprecated_only": {
+                    "type": "boolean",
+                    "description": (
+                        "Return only symbols flagged deprecated (default: "
+                        "output.deprecated_only config, else false)."
+                    ),
+                },
+                "min_complexity": {
+                    "type": "integer",
+                    "description": (
+                        "Return only Go functions and methods whose cyclomatic complexity is "
+                        "at least this value (default: output.min_complexity config, else no "
+                        "filter)."
+                    ),
+                },
             },
             "required": ["path"],
         },
@@ -229,6 +252,273 @@ TOOL_SCHEMAS: dict[str, dict[str, object]] = {
             "required": ["symbol"],
         },
     },
+    "repo.export_symbols": {
+        "name": "repo.export_symbols",
+        "description": (
+            "Outline every discovered file and write the symbols to an export artifact "
+            "under the server data_dir. 'json' writes one document; 'jsonl' streams one "
+            "file symbol group per line as each file is parsed; 'markdown' writes a "
+            "human-readable API overview. Returns the artifact path and counts, not the "
+            "symbols themselves."
+        ),
+        "inputSchema": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "type": "string",
+                    "enum": ["json", "jsonl", "markdown", "sarif", "dot", "sqlite"],
+                    "description": (
+                        "Export format: 'json', 'jsonl', 'markdown', 'sarif' lint findings, "
+                        "a 'dot' Graphviz graph, or a 'sqlite' database upserted in place "
+                        "(default: output.format config, else 'json')."
+                    ),
+                },
+                "graph_level": {
+                    "type": "string",
+                    "enum": ["package", "file", "symbol"],
+                    "description": (
+                        "Node granularity of the 'dot' graph (default: output.graph_level "
+                        "config, else 'package')."
+                    ),
+                },
+                "group_by_type": {
+                    "type": "boolean",
+                    "description": (
+                        "Nest methods and members under their type as 'children' in 'json', "
+                        "'jsonl', and 'markdown' exports. Defaults to output.group_by_type "
+                        "from config or --group-by-type."
+                    ),
+                },
+                "concurrency": {
+                    "type": "integer",
+                    "description": (
+                        "Parallel file workers (1-64). Defaults to scan.concurrency from "
+                        "config, else the host CPU count. Output order does not depend on it."
+                    ),
+                },
+                "force": {
+                    "type": "boolean",
+                    "description": (
+                        "Ignore the symbol cache and re-parse every file (default false). "
+                        "The cache is still rewritten afterwards."
+                    ),
+                },
+                "strict": {
+                    "type": "boolean",
+                    "description": (
+                        "Fail with PARSE_FAILED when any file reports a parse diagnostic. "
+                        "Defaults to scan.strict from config or --strict."
+                    ),
+                },
+                "skip_tests": {
+                    "type": "boolean",
+                    "description": (
+                        "Leave out test files (Go `_test.go`). Defaults to scan.skip_tests "
+                        "from config or --skip-tests."
+                    ),
+                },
+            },
+        },
+    },
+    "repo.pack_symbols": {
+        "name": "repo.pack_symbols",
+        "description": (
+            "Render the most relevant repository symbols compactly within a token "
+            "budget, for prompt construction. Exported and documented symbols are "
+            "selected first; selection stops before the estimate would exceed "
+            "max_tokens. Returns the packed text and a manifest marking each file as "
+            "fully included, partially included, or dropped."
+        ),
+        "inputSchema": {
+            "type": "object",
+            "properties": {
+                "max_tokens": {
+                    "type": "integer",
+                    "description": "Token budget for the packed text (1-1000000).",
+                },
+                "chars_per_token": {
+                    "type": "integer",
+                    "description": (
+                        "Characters counted as one token by the estimator (1-16, default 4)."
+                    ),
+                },
+                "skip_tests": {
+                    "type": "boolean",
+                    "description": (
+                        "Leave out test files (Go `_test.go`). Defaults to scan.skip_tests "
+                        "from config or --skip-tests."
+                    ),
+                },
+            },
+            "required": ["max_tokens"],
+        },
+    },
+    "repo.query_symbols": {
+        "name": "repo.query_symbols",
+        "description": (
+            "Filter repository symbols by kind, visibility, parsed return type, and "
+            "package, e.g. public functions in package 'worker' that return 'error'. "
+            "Unchanged files are served from the symbol cache. Results use the same "
+            "file group shape as repo.export_symbols, or its Markdown overview."
+        ),
+        "inputSchema": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "description": "Exact symbol kind, e.g. 'function', 'method', 'type'.",
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": ["public", "private"],
+                    "description": "Keep only symbols with this visibility.",
+                },
+                "returns": {
+                    "type": "string",
+                    "description": (
+                        "Keep functions and methods with this parsed return type, e.g. "
+                        "'error' (Go and annotated Python only)."
+                    ),
+                },
+                "package": {
+                    "type": "string",
+                    "description": (
+                        "Keep symbols qualified by this package name or declared in a "
+                        "directory of that name."
+                    ),
+                },
+                "deprecated": {
+                    "type": "boolean",
+                    "description": (
+                        "true keeps only symbols flagged deprecated, e.g. for a migration "
+                        "checklist; false drops them."
+                    ),
+                },
+                "min_complexity": {
+                    "type": "integer",
+                    "description": (
+                        "Keep Go functions and methods whose cyclomatic complexity is at "
+                        "least this value, e.g. 10 for review triage."
+                    ),
+                },
+                "format": {
+                    "type": "string",
+                    "enum": ["json", "markdown"],
+                    "description": "Result format: 'json' (default) or 'markdown'.",
+                },
+                "skip_tests": {
+                    "type": "boolean",
+                    "description": (
+                        "Leave out test files (Go `_test.go`). Defaults to scan.skip_tests "
+                        "from config or --skip-tests."
+                    ),
+                },
+            },
+        },
+    },
+    "repo.search_symbols": {
+        "name": "repo.search_symbols",
+        "description": (
+            "Ranked search over symbol names, signatures, and doc comments, e.g. 'retry'. "
+            "Matches whole terms (camelCase and snake_case parts count) and substrings; "
+            "name matches outrank signature and doc matches. Each hit carries its file "
+            "location. Unchanged files are served from the symbol cache."
+        ),
+        "inputSchema": {
+            "type": "object",
+            "properties": {
+                "query": {
+                    "type": "string",
+                    "description": "Search text; every word is matched separately.",
+                },
+                "limit": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "description": "Maximum hits returned. Defaults to max_search_hits.",
+                },
+                "format": {
+                    "type": "string",
+                    "enum": ["json", "markdown"],
+                    "description": "Result format: 'json' (default) or 'markdown'.",
+                },
+                "skip_tests": {
+                    "type": "boolean",
+                    "description": (
+                        "Leave out test files (Go `_test.go`). Defaults to scan.skip_tests "
+                        "from config or --skip-tests."
+                    ),
+                },
+            },
+            "required": ["query"],
+        },
+    },
+    "repo.summary": {
+        "name": "repo.summary",
+        "description": (
+            "High-level repository profile from one symbol scan: file, line, and symbol "
+            "totals per language, symbol counts by kind, exported vs unexported symbols "
+            "and kinds per package, and the largest files by line count. Unchanged files "
+            "are served from the symbol cache."
+        ),
+        "inputSchema": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "type": "string",
+                    "enum": ["json", "markdown"],
+                    "description": "Result format: 'json' (default) or 'markdown' tables.",
+                },
+                "skip_tests": {
+                    "type": "boolean",
+                    "description": (
+                        "Leave out test files (Go `_test.go`). Defaults to scan.skip_tests "
+                        "from config or --skip-tests."
+                    ),
+                },
+            },
+        },
+    },
+    "repo.diff_symbols": {
+        "name": "repo.diff_symbols",
+        "description": (
+            "Compare two repo.export_symbols JSON or JSONL artifacts, or one artifact "
+            "against the current tree, and report added, removed, and changed symbols "
+            "with before/after signatures. Removals and signature changes of public "
+            "symbols are flagged as breaking; fail_on sets the `failed` flag for CI gates."
+        ),
+        "inputSchema": {
+            "type": "object",
+            "properties": {
+                "old": {
+                    "type": "string",
+                    "description": "Repo-relative path of the baseline symbol export.",
+                },
+                "new": {
+                    "type": "string",
+                    "description": (
+                        "Repo-relative path of the export to compare; omit to scan the "
+                        "current tree."
+                    ),
+                },
+                "fail_on": {
+                    "type": "string",
+                    "enum": ["none", "breaking", "any"],
+                    "description": (
+                        "Set `failed` on breaking changes ('breaking') or on any change "
+                        "('any'). Default 'none'."
+                    ),
+                },
+                "skip_tests": {
+                    "type": "boolean",
+                    "description": (
+                        "When scanning the current tree, leave out test files. Defaults to "
+                        "scan.skip_tests from config or --skip-tests."
+                    ),
+                },
+            },
+            "required": ["old"],
+        },
+    },
     "repo.refresh_index": {
         "name": "repo.refresh_index",
         "description": (
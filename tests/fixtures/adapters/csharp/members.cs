@@ -0,0 +1,49 @@
+using System;
+
+namespace Acme.Billing
+{
+    [Serializable, Obsolete("Use InvoiceV2 instead.")]
+    public partial class Invoice
+    {
+        public const int MaxLines = 50;
+        private readonly string _number, _currency;
+        internal static decimal Rate = 1.5m;
+        decimal total;
+
+        public string Number { get; private set; }
+
+        public decimal Total
+        {
+            get { return total; }
+            protected set { total = value; }
+        }
+
+        public bool IsEmpty => total == 0;
+
+        public event EventHandler? Paid;
+
+        [Obsolete]
+        protected internal void Recalculate(int precision)
+        {
+            total = Math.Round(total, precision);
+        }
+
+        partial void OnPaid();
+
+        private class Line
+        {
+            public int Quantity { get; init; }
+        }
+    }
+
+    internal interface ILedger
+    {
+        void Post(Invoice invoice);
+    }
+
+    enum Status
+    {
+        Open,
+        Paid,
+    }
+}
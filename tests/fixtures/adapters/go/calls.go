@@ -0,0 +1,46 @@
+package graph
+
+import (
+	"fmt"
+	str "strings"
+)
+
+type Node struct {
+	name  string
+	edges []*Node
+}
+
+func NewNode(name string) *Node {
+	return &Node{name: str.TrimSpace(name)}
+}
+
+func (n *Node) Link(other *Node) {
+	n.edges = append(n.edges, other)
+	n.validate()
+}
+
+func (n *Node) validate() {
+	if len(n.name) == 0 {
+		panic(fmt.Sprintf("empty node %q", "Link(x)"))
+	}
+}
+
+// Walk counts reachable nodes; Walk(root) in comments is ignored.
+func Walk(root *Node, visit func(*Node)) int {
+	visit(root)
+	count := 1
+	for _, edge := range root.edges {
+		count += Walk(edge, visit)
+	}
+	if Node(*root).name == "" {
+		fmt.Println(int64(count))
+	}
+	return count
+}
+
+func Build() *Node {
+	root := NewNode("root")
+	root.Link(NewNode("leaf"))
+	Walk(root, func(n *Node) { n.validate() })
+	return root
+}
@@ -0,0 +1,45 @@
+package pool
+
+import (
+	stdctx "context"
+	"sync"
+)
+
+// Fanout starts one goroutine per job and collects results on a channel.
+func Fanout(ctx stdctx.Context, jobs []string) []string {
+	results := make(chan string, len(jobs))
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			results <- name
+		}(job)
+	}
+	wg.Wait()
+	close(results)
+	return drain(results)
+}
+
+func drain(results <-chan string) []string {
+	var out []string
+	for item := range results {
+		out = append(out, item)
+	}
+	return out
+}
+
+// Spawn runs fn in the background.
+func Spawn[T any](ctx, parent stdctx.Context, fn func(T)) {
+	go fn(*new(T))
+}
+
+func (p *Pool) Wait() {
+	// go doc mentions are comments, and "<- chan" is a string.
+	_ = "go <- chan"
+	p.gopher()
+}
+
+type Pool struct{}
+
+func (p *Pool) gopher() {}
@@ -0,0 +1,11 @@
+// Copyright notice for the fixture.
+
+//go:build (linux || darwin) && !cgo
+// +build linux darwin
+// +build !cgo
+
+// Package poller waits on file descriptors.
+package poller
+
+// Wait blocks until the descriptor is ready.
+func Wait(fd int) error { return nil }
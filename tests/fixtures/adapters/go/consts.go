@@ -0,0 +1,40 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// Mode selects a scheduling strategy.
+type Mode int
+
+const (
+	Fast Mode = iota
+	Slow
+	_
+	Paused
+)
+
+const (
+	KB = 1 << (10 * (iota + 1))
+	MB
+	GB
+)
+
+const (
+	DefaultName         = "svc" // shown in logs
+	Ratio               = 1.5
+	Timeout             time.Duration = 5 * time.Second
+	Separator    rune   = ','
+	MaxAttempts  int64  = 9
+)
+
+var (
+	Enabled = true
+	cache   = map[string]int{}
+	client  = &Client{name: "default"}
+	host, port = "localhost", 8080
+	counter int
+)
+
+var ErrClosed = errors.New("closed")
@@ -0,0 +1,64 @@
+package collections
+
+import "golang.org/x/exp/constraints"
+
+// Stack is a LIFO container.
+type Stack[T comparable] struct {
+	items []T
+}
+
+type Pair[K comparable, V any] map[K]V
+
+type Grid [4]int
+
+func Map[T any, U any](in []T, f func(T) U) []U {
+	out := make([]U, 0, len(in))
+	for _, item := range in {
+		out = append(out, f(item))
+	}
+	return out
+}
+
+func Max[T constraints.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func (s *Stack[T]) Push(value T) {
+	s.items = append(s.items, value)
+}
+
+func Reduce[T, A any](
+	in []T,
+	initial A,
+	step func(A, T) A,
+) A {
+	acc := initial
+	for _, item := range in {
+		acc = step(acc, item)
+	}
+	return acc
+}
+
+// ReadCloserStack combines reading with stack access.
+type ReadCloserStack[T comparable] interface {
+	io.Reader
+	// Pop removes the top item.
+	Pop() (T, bool)
+	Peek(
+		depth int,
+	) T
+	~[]T | ~map[int]T
+}
+
+type Node[T comparable] struct {
+	*Stack[T]
+	sync.Mutex
+	Left, Right *Node[T]
+	value       T // stored item
+	meta        struct {
+		depth int
+	}
+}
@@ -0,0 +1,53 @@
+package shapes
+
+import (
+	"context"
+	"io"
+)
+
+type Runner interface {
+	Run(ctx context.Context) error
+}
+
+type Namer interface {
+	Name() string
+}
+
+type RunNamer interface {
+	Runner
+	Namer
+}
+
+type ReadNamer interface {
+	io.Reader
+	Namer
+}
+
+type Number interface {
+	~int | ~float64
+}
+
+type Base struct{}
+
+func (Base) Name() string { return "base" }
+
+type Service struct {
+	Base
+	label string
+}
+
+func (s *Service) Run(c context.Context) error { return nil }
+
+type Job struct{}
+
+func (j Job) Run(ctx context.Context) error { return nil }
+
+func (j *Job) Name() string { return "job" }
+
+type Celsius float64
+
+func (c Celsius) Name() string { return "celsius" }
+
+type Broken struct{}
+
+func (b Broken) Run() error { return nil }
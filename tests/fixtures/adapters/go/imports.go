@@ -0,0 +1,18 @@
+package client
+
+import "errors"
+
+import (
+	"fmt"
+	_ "embed"
+	. "math"
+	yaml "gopkg.in/yaml.v3"
+	"github.com/example/api/v2"
+	"net/http" // unused: http.Get appears only in this comment
+)
+
+func Fetch() error {
+	_ = Pi
+	_ = yaml.Marshal
+	return fmt.Errorf("wrap: %w", errors.New(api.Name))
+}
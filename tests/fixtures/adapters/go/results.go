@@ -0,0 +1,24 @@
+package worker
+
+type Store interface {
+	Load(key string) ([]byte, error)
+}
+
+func Ping() {}
+
+func Open(path string) (*Store, error) {
+	return nil, nil
+}
+
+func Split(in string) (head, tail string, err error) {
+	return
+}
+
+func Empty() interface{} { return nil }
+
+func Lines() (
+	[]string,
+	error,
+) {
+	return nil, nil
+}
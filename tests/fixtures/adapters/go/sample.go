@@ -1,29 +1,40 @@
+// Package worker runs named services.
 package worker
 
 import "context"
 
+// Runner executes one unit of work.
 type Runner interface {
 	Run(ctx context.Context) error
 }
 
+// Service holds worker configuration.
+//
+// The zero value is not usable; call Build.
 type Service struct {
 	name string
 }
 
+// Defaults shared by every service.
 const (
 	DefaultName = "svc"
-	MaxRetries  = 3
+	// MaxRetries bounds the retry loop.
+	MaxRetries = 3
 )
 
 var (
-	GlobalEnabled = true
+	GlobalEnabled = true  // toggled by tests
 	globalVersion = "dev"
 )
 
+/*
+Build returns a Service with the given name.
+*/
 func Build(name string) *Service {
 	return &Service{name: name}
 }
 
+//go:noinline
 func (s *Service) Run(ctx context.Context) error {
 	_ = ctx
 	return nil
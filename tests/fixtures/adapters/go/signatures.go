@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"context"
+	log "github.com/acme/logging/v2"
+)
+
+// Copy copies n bytes.
+func Copy(dst, src []byte, n int) (written int, err error) { return 0, nil }
+
+// Pair builds a pair.
+func Pair[K, V comparable](key K, value V) map[K]V { return nil }
+
+// Open opens a logger.
+func Open(ctx context.Context, name string) (*log.Logger, error) { return nil, nil }
+
+// Reader consumes events.
+type Reader interface {
+	Read(ctx context.Context, from, to int) (n int, err error)
+}
+
+// Drain reads every event.
+func Drain(
+	ch <-chan  []byte,
+	fn func(x, y int) error,
+) error { return nil }
@@ -0,0 +1,23 @@
+package worker
+
+import (
+	"context"
+	xlog "github.com/acme/logging/v2"
+)
+
+// Copy copies n bytes.
+func Copy(dst []byte, src []byte, n int) (int, error) { return 0, nil }
+
+// Pair builds a pair.
+func Pair[K comparable, V comparable](key K, value V) (m map[K]V) { return nil }
+
+// Open opens a logger.
+func Open(ctx context.Context, name string) (*xlog.Logger, error) { return nil, nil }
+
+// Reader consumes events.
+type Reader interface {
+	Read(ctx context.Context, from int, to int) (int, error)
+}
+
+// Drain reads every event.
+func Drain(ch <-chan [] byte, fn func( x, y int ) error) error { return nil }
@@ -0,0 +1,30 @@
+package api
+
+import "time"
+
+// Base carries audit metadata shared by every record.
+type Base struct {
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// Service describes a registered service.
+type Service struct {
+	Base    `json:",inline"`
+	*Owner  `json:"owner,omitempty"`
+	Name    string `json:"name" validate:"required"`
+	Port    int    `json:"port,omitempty" validate:"min=1,max=65535"`
+	private string
+	Labels  map[string]string "json:\"labels\""
+	Config  struct {
+		Timeout time.Duration `yaml:"timeout"`
+	} `json:"config"`
+	Legacy string `custom format; not key:value`
+	Mixed  string `json:"mixed" broken`
+	// Retries bounds reconnects.
+	Retries int `json:"retries"` // zero disables retries
+}
+
+// Owner identifies who operates a service.
+type Owner struct {
+	Email string `json:"email"`
+}
@@ -0,0 +1,24 @@
+package worker
+
+import (
+	"context"
+	"io"
+	log "github.com/acme/logging/v2"
+)
+
+type Store[K comparable, V any] struct {
+	items map[K]V
+	next  *Store[K, V]
+	io.Reader
+	hook func(ctx context.Context, s *Service) error
+}
+
+type Runner interface {
+	Run(ctx context.Context) (Result, error)
+}
+
+func (s *Store[K, V]) Get(key K) (V, bool) { var v V; return v, false }
+
+func Build[T fmt.Stringer](name T, opts ...Option) (*Service, log.Logger) { return nil, nil }
+
+func Plain(m map[string][]Item, ch <-chan Event, f func(int) Result) {}
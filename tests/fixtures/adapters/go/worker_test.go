@@ -0,0 +1,37 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type fixture struct{}
+
+func (f fixture) TestHelper() {}
+
+func TestBuild(t *testing.T) {}
+
+func Test_edgeCase(t *testing.T) {}
+
+func Testify() {}
+
+func BenchmarkBuild(b *testing.B) {}
+
+func FuzzParse(f *testing.F) {}
+
+func Example() {}
+
+func ExampleBuild() {
+	svc := Build("indexer")
+	fmt.Println(svc.name)
+	// Output: indexer
+}
+
+func ExampleService_Run() {
+	_ = Build("indexer").Run(context.Background())
+}
+
+func Example_suffix() {}
+
+func newFixture() fixture { return fixture{} }
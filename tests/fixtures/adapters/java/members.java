@@ -0,0 +1,40 @@
+package com.example.app;
+
+import java.util.List;
+import java.util.Map;
+
+@Component
+@SuppressWarnings("unchecked")
+public class Registry<T> {
+    public static final int MAX = 3, MIN = 1;
+    protected Map<String, List<Integer>> table = new HashMap<>();
+    String label;
+    private int[] counts;
+
+    @Override
+    public String toString() {
+        return "registry";
+    }
+
+    @Deprecated(since = "9") protected void reset() {
+    }
+
+    static class Entry {
+        private final String key;
+
+        Entry(String key) {
+            this.key = key;
+        }
+
+        public interface Visitor {
+            int LIMIT = 5;
+
+            void visit(Entry entry);
+        }
+    }
+
+    void rebuild() {
+        class Scratch {}
+        int local = 3;
+    }
+}
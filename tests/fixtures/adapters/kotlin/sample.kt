@@ -0,0 +1,62 @@
+package com.example.service
+
+import kotlin.math.max
+
+interface Runner {
+    fun run(input: String): String
+}
+
+enum class Mode {
+    FAST,
+    SLOW,
+}
+
+data class User(
+    val id: Int,
+    var name: String = "anonymous",
+)
+
+sealed class Result {
+    data class Ok(val value: String) : Result()
+    object Failed : Result()
+}
+
+object Registry {
+    internal val users = mutableListOf<User>()
+
+    fun register(user: User) {
+        users.add(user)
+    }
+}
+
+class Service private constructor(private val name: String) : Runner {
+    var calls: Int = 0
+        private set
+
+    protected open val label: String
+        get() = "service:$name"
+
+    override fun run(input: String): String {
+        calls += 1
+        return "$name:$input"
+    }
+
+    @Deprecated("Use run")
+    fun legacy(input: String): String = run(input)
+
+    companion object {
+        const val DEFAULT_NAME = "default"
+
+        fun create(name: String = DEFAULT_NAME): Service = Service(name)
+    }
+}
+
+private fun parse(value: Int): Int {
+    val local = value + 1
+    return max(local, 0)
+}
+
+fun String.shout(): String = uppercase() + "!"
+
+val <T> List<T>.second: T
+    get() = this[1]
@@ -1,7 +1,51 @@
+"""Worker services."""
+
+from __future__ import annotations
+
+import asyncio
+from dataclasses import dataclass
+from typing import Protocol, TypeVar
+
+DEFAULT_NAME = "svc"
+MAX_RETRIES: int = 3
+T = TypeVar("T")
+
+global_enabled = True
+_global_version = "dev"
+
+
+class Runner(Protocol):
+    """Runner executes one unit of work."""
+
+    async def run(self, value: int) -> int: ...
+
+
+@dataclass(frozen=True)
 class Service:
+    """Service holds worker configuration."""
+
+    name: str = DEFAULT_NAME
+
     def run(self, value: int) -> int:
         return value + 1
 
+    @staticmethod
+    def describe() -> str:
+        return "service"
+
+    class Options:
+        RETRIES = MAX_RETRIES
+
+
+def build(name: str) -> Service:
+    """Build returns a Service with the given name."""
+
+    def normalize(raw: str) -> str:
+        return raw.strip()
+
+    return Service(name=normalize(name))
+
 
-def build(name: str) -> str:
-    return name
+async def run_all(services: list[Service]) -> list[int]:
+    await asyncio.sleep(0)
+    return [service.run(1) for service in services]
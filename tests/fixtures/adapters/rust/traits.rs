@@ -0,0 +1,55 @@
+use std::fmt;
+
+pub(crate) struct Queue<T> {
+    items: Vec<T>,
+}
+
+pub enum State {
+    /// Waiting for work.
+    Idle,
+    Busy(u32),
+    #[allow(dead_code)]
+    Failed { code: i32, reason: String },
+}
+
+pub trait Worker {
+    fn start(&mut self) -> bool;
+    fn name(&self) -> &str {
+        "worker"
+    }
+}
+
+static mut COUNTER: u32 = 0;
+pub static LIMIT: usize = 8;
+
+pub const fn capacity() -> usize {
+    LIMIT
+}
+
+impl<T> Queue<T> {
+    pub(crate) fn push(&mut self, item: T) {
+        self.items.push(item);
+    }
+
+    fn len(&self) -> usize {
+        self.items.len()
+    }
+}
+
+impl<T: Send> Worker for Queue<T> {
+    fn start(&mut self) -> bool {
+        true
+    }
+}
+
+impl fmt::Display for State {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        write!(f, "state")
+    }
+}
+
+impl<T> From<Vec<T>> for Queue<T> {
+    fn from(items: Vec<T>) -> Self {
+        Queue { items }
+    }
+}
@@ -0,0 +1,52 @@
+import { EventEmitter } from "events";
+
+export interface Options {
+  retries: number;
+}
+
+export type Handler = (value: string) => void;
+
+type Internal = { raw: string };
+
+export const DEFAULT_RETRIES = 3;
+const cache = new Map<string, string>();
+let counter = 0;
+
+export default class Registry extends EventEmitter {
+  static instances = 0;
+  readonly name: string;
+  private store: Map<string, Handler> = new Map();
+  protected retries?: number;
+  #secret = "token";
+  onChange = (value: string): void => {
+    this.emit("change", value);
+  };
+
+  constructor(name: string) {
+    super();
+    this.name = name;
+  }
+
+  register(key: string, handler: Handler): void {
+    this.store.set(key, handler);
+  }
+
+  private resolve(key: string): Handler | undefined {
+    return this.store.get(key);
+  }
+
+  static async create(name: string): Promise<Registry> {
+    return new Registry(name);
+  }
+}
+
+function normalize(raw: string): string {
+  counter += 1;
+  return raw.trim();
+}
+
+async function load(path: string): Promise<Internal> {
+  return { raw: normalize(path) };
+}
+
+export { load, normalize as clean };
@@ -0,0 +1,20 @@
+import { EventEmitter, type Listener as L } from "events";
+import * as path from "path";
+import fs, { readFile } from "fs";
+import "./polyfill";
+// import ghost from "ghost";
+import type {
+  Options,
+  Unused,
+} from "./types";
+const lodash = require("lodash");
+const { join: pjoin, dirname } = require("path");
+export { thing } from "./thing";
+
+export class Loader extends EventEmitter {
+  options?: Options;
+
+  load(name: string): string {
+    return path.join(fs.realpathSync("."), pjoin(name, String(readFile)), "lodash");
+  }
+}
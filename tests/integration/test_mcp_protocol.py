@@ -16,6 +16,12 @@ EXPECTED_TOOLS = [
     "repo.build_context_bundle",
     "repo.references",
     "repo.find_definition",
+    "repo.export_symbols",
+    "repo.pack_symbols",
+    "repo.query_symbols",
+    "repo.search_symbols",
+    "repo.summary",
+    "repo.diff_symbols",
     "repo.refresh_index",
     "repo.audit_log",
 ]
@@ -131,7 +137,7 @@ def test_full_mcp_handshake_and_tool_call() -> None:
     assert init_resp["id"] == 1
     assert init_resp["result"]["protocolVersion"] == "2024-11-05"
     assert list_resp["id"] == 2
-    assert len(list_resp["result"]["tools"]) == 10
+    assert len(list_resp["result"]["tools"]) == len(EXPECTED_TOOLS)
     assert call_resp["id"] == 3
     assert not call_resp["result"].get("isError")
 
@@ -28,6 +28,7 @@ def test_multilanguage_outline_is_stable_across_repeated_and_path_style_calls(
         "src/sample.rs": _load_fixture("tests/fixtures/adapters/rust/sample.rs"),
         "src/sample.cpp": _load_fixture("tests/fixtures/adapters/cpp/sample.cpp"),
         "src/sample.cs": _load_fixture("tests/fixtures/adapters/csharp/sample.cs"),
+        "src/sample.kt": _load_fixture("tests/fixtures/adapters/kotlin/sample.kt"),
         "docs/sample.md": _load_fixture("tests/fixtures/adapters/lexical/sample.md"),
     }
     expected_language = {
@@ -39,6 +40,7 @@ def test_multilanguage_outline_is_stable_across_repeated_and_path_style_calls(
         "src/sample.rs": "rust_lexical",
         "src/sample.cpp": "cpp_lexical",
         "src/sample.cs": "csharp_lexical",
+        "src/sample.kt": "kotlin_lexical",
         "docs/sample.md": "lexical",
     }
 
@@ -0,0 +1,94 @@
+from __future__ import annotations
+
+import shutil
+from pathlib import Path
+
+from tests.helpers import call_tool, extract_result, is_tool_error, tool_error_text
+
+from repo_mcp.config import CliOverrides
+from repo_mcp.server import create_server
+
+
+def _write_worker(root: Path, body: str) -> None:
+    (root / "worker").mkdir(exist_ok=True)
+    (root / "worker" / "worker.go").write_text(f"package worker\n\n{body}", encoding="utf-8")
+
+
+def _export_baseline(root: Path, name: str) -> str:
+    server = create_server(repo_root=str(root))
+    result = extract_result(call_tool(server, f"req-{name}", "repo.export_symbols", {}))
+    (root / "api").mkdir(exist_ok=True)
+    shutil.copy(result["artifact_path"], root / "api" / f"{name}.json")
+    return f"api/{name}.json"
+
+
+def test_repo_diff_symbols_reports_breaking_signature_change(tmp_path: Path) -> None:
+    _write_worker(tmp_path, "func Build(name string) {}\n\nfunc Stop() {}\n")
+    old = _export_baseline(tmp_path, "old")
+    _write_worker(tmp_path, "func Build(name string, retries int) {}\n\nfunc Start() {}\n")
+    new = _export_baseline(tmp_path, "new")
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(
+        call_tool(
+            server,
+            "req-diff-1",
+            "repo.diff_symbols",
+            {"old": old, "new": new, "fail_on": "breaking"},
+        )
+    )
+
+    assert [entry["name"] for entry in result["added"]] == ["worker.Start"]
+    assert [entry["name"] for entry in result["removed"]] == ["worker.Stop"]
+    [changed] = result["changed"]
+    assert changed["name"] == "worker.Build"
+    assert changed["before"]["signature"] == "(name string)"
+    assert changed["after"]["signature"] == "(name string, retries int)"
+    assert changed["breaking"] is True
+    assert (result["breaking_count"], result["failed"]) == (2, True)
+
+
+def test_repo_diff_symbols_compares_against_current_tree(tmp_path: Path) -> None:
+    _write_worker(tmp_path, "func Build(name string) {}\n")
+    old = _export_baseline(tmp_path, "old")
+    _write_worker(tmp_path, "func Build(name string) {}\n\nfunc Start() {}\n")
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(call_tool(server, "req-diff-2", "repo.diff_symbols", {"old": old}))
+
+    assert result["new"] is None
+    assert [entry["path"] for entry in result["added"]] == ["worker/worker.go"]
+    assert result["removed"] == [] and result["changed"] == []
+    assert (result["fail_on"], result["failed"]) == ("none", False)
+
+
+def test_repo_diff_symbols_rejects_invalid_inputs(tmp_path: Path) -> None:
+    _write_worker(tmp_path, "func Build() {}\n")
+    (tmp_path / "notes.md").write_text("# Notes\n", encoding="utf-8")
+    server = create_server(repo_root=str(tmp_path))
+
+    cases = [
+        ({}, "old must be a non-empty string"),
+        ({"old": "notes.md", "fail_on": "always"}, "fail_on must be one of: none, breaking, any"),
+        ({"old": "missing.json"}, "old is not a readable file"),
+        ({"old": "notes.md"}, "old is not a symbol export"),
+    ]
+    for index, (arguments, message) in enumerate(cases):
+        response = call_tool(server, f"req-diff-e{index}", "repo.diff_symbols", arguments)
+        assert is_tool_error(response)
+        assert message in tool_error_text(response)
+
+
+def test_repo_diff_symbols_reads_exports_larger_than_max_file_bytes(tmp_path: Path) -> None:
+    functions = "".join(f"func Step{index}(name string) {{}}\n\n" for index in range(60))
+    _write_worker(tmp_path, functions)
+    old = _export_baseline(tmp_path, "old")
+    assert (tmp_path / old).stat().st_size > 4096
+    server = create_server(repo_root=str(tmp_path), cli_overrides=CliOverrides(max_file_bytes=4096))
+
+    opened = call_tool(server, "req-diff-size-1", "repo.open_file", {"path": old})
+    result = extract_result(call_tool(server, "req-diff-size-2", "repo.diff_symbols", {"old": old}))
+
+    assert is_tool_error(opened)
+    assert "max_file_bytes" in tool_error_text(opened)
+    assert result["added"] == [] and result["removed"] == [] and result["changed"] == []
@@ -2,7 +2,7 @@ from __future__ import annotations
 
 from pathlib import Path
 
-from tests.helpers import call_tool, extract_result
+from tests.helpers import call_tool, extract_result, is_tool_error, tool_error_text
 
 from repo_mcp.server import create_server
 
@@ -40,6 +40,38 @@ def test_repo_outline_tool_uses_python_adapter(tmp_path: Path) -> None:
         "scope_kind",
         "is_conditional",
         "decl_context",
+        "decorators",
+        "visibility",
+        "start_col",
+        "tag",
+        "tags",
+        "calls",
+        "returns",
+        "is_test",
+        "role",
+        "implements",
+        "package",
+        "qualified_name",
+        "deprecated",
+        "deprecation_note",
+        "value",
+        "value_type",
+        "iota_value",
+        "id",
+        "access",
+        "build_constraints",
+        "spawns_goroutine",
+        "uses_channels",
+        "takes_context",
+        "accessors",
+        "partial",
+        "receiver",
+        "receiver_name",
+        "references",
+        "complexity",
+        "line_count",
+        "examples",
+        "canonical_signature",
     }
     assert result["symbols"][0]["scope_kind"] == "module"
     assert result["symbols"][0]["parent_symbol"] is None
@@ -47,3 +79,133 @@ def test_repo_outline_tool_uses_python_adapter(tmp_path: Path) -> None:
     assert result["symbols"][1]["scope_kind"] == "class"
     assert result["symbols"][1]["parent_symbol"] == "A"
     assert result["symbols"][2]["scope_kind"] == "module"
+    assert [symbol["start_col"] for symbol in result["symbols"]] == [7, 9, 5]
+
+
+def test_repo_outline_public_only_filters_to_exported_symbols(tmp_path: Path) -> None:
+    (tmp_path / "src").mkdir()
+    (tmp_path / "src" / "worker.go").write_text(
+        "package worker\n"
+        "\n"
+        "var GlobalEnabled = true\n"
+        "var globalVersion = \"dev\"\n"
+        "\n"
+        "func Build() {}\n"
+        "func helper() {}\n",
+        encoding="utf-8",
+    )
+    (tmp_path / "src" / "mod.py").write_text(
+        "class Api:\n"
+        "    def __init__(self) -> None:\n"
+        "        pass\n"
+        "\n"
+        "    def _hidden(self) -> None:\n"
+        "        pass\n"
+        "\n"
+        "def _private() -> None:\n"
+        "    pass\n",
+        encoding="utf-8",
+    )
+    server = create_server(repo_root=str(tmp_path))
+
+    full = extract_result(call_tool(server, "req-vis-1", "repo.outline", {"path": "src/worker.go"}))
+    visibility = {symbol["name"]: symbol["visibility"] for symbol in full["symbols"]}
+    assert visibility == {
+        "worker.GlobalEnabled": "public",
+        "worker.globalVersion": "private",
+        "worker.Build": "public",
+        "worker.helper": "private",
+    }
+
+    go_public = extract_result(
+        call_tool(
+            server, "req-vis-2", "repo.outline", {"path": "src/worker.go", "public_only": True}
+        )
+    )
+    assert [symbol["name"] for symbol in go_public["symbols"]] == [
+        "worker.GlobalEnabled",
+        "worker.Build",
+    ]
+
+    py_public = extract_result(
+        call_tool(server, "req-vis-3", "repo.outline", {"path": "src/mod.py", "public_only": True})
+    )
+    assert [symbol["name"] for symbol in py_public["symbols"]] == ["Api", "Api.__init__"]
+
+    invalid = call_tool(
+        server, "req-vis-4", "repo.outline", {"path": "src/mod.py", "public_only": "yes"}
+    )
+    assert is_tool_error(invalid)
+    assert "public_only must be a boolean" in tool_error_text(invalid)
+
+
+def test_repo_outline_reports_file_imports(tmp_path: Path) -> None:
+    (tmp_path / "src").mkdir()
+    (tmp_path / "src" / "main.go").write_text(
+        "package main\n"
+        "\n"
+        "import (\n"
+        '\t"fmt"\n'
+        '\t"os"\n'
+        ")\n"
+        "\n"
+        "func main() { fmt.Println() }\n",
+        encoding="utf-8",
+    )
+    (tmp_path / "src" / "notes.md").write_text("# Notes\n", encoding="utf-8")
+    server = create_server(repo_root=str(tmp_path))
+
+    go_result = extract_result(
+        call_tool(server, "req-imports-1", "repo.outline", {"path": "src/main.go"})
+    )
+    assert go_result["imports"] == [
+        {"path": "fmt", "name": None, "alias": None, "line": 4, "used": True},
+        {"path": "os", "name": None, "alias": None, "line": 5, "used": False},
+    ]
+
+    md_result = extract_result(
+        call_tool(server, "req-imports-2", "repo.outline", {"path": "src/notes.md"})
+    )
+    assert md_result["imports"] is None
+
+
+def test_repo_outline_deprecated_only_argument_and_config_default(tmp_path: Path) -> None:
+    (tmp_path / "src").mkdir()
+    (tmp_path / "src" / "mod.py").write_text(
+        "from warnings import deprecated\n"
+        "\n"
+        "@deprecated('Use run().')\n"
+        "def start():\n"
+        "    pass\n"
+        "\n"
+        "def run():\n"
+        "    pass\n",
+        encoding="utf-8",
+    )
+    server = create_server(repo_root=str(tmp_path))
+
+    everything = extract_result(
+        call_tool(server, "req-dep-1", "repo.outline", {"path": "src/mod.py"})
+    )
+    deprecated = extract_result(
+        call_tool(
+            server, "req-dep-2", "repo.outline", {"path": "src/mod.py", "deprecated_only": True}
+        )
+    )
+
+    assert [symbol["name"] for symbol in everything["symbols"]] == ["start", "run"]
+    assert [symbol["name"] for symbol in deprecated["symbols"]] == ["start"]
+    assert deprecated["symbols"][0]["deprecation_note"] == "Use run()."
+
+    (tmp_path / "repo_mcp.toml").write_text("[output]\ndeprecated_only = true\n", "utf-8")
+    configured = create_server(repo_root=str(tmp_path))
+    result = extract_result(
+        call_tool(configured, "req-dep-3", "repo.outline", {"path": "src/mod.py"})
+    )
+    assert [symbol["name"] for symbol in result["symbols"]] == ["start"]
+
+    invalid = call_tool(
+        server, "req-dep-4", "repo.outline", {"path": "src/mod.py", "deprecated_only": 1}
+    )
+    assert is_tool_error(invalid)
+    assert "deprecated_only must be a boolean" in tool_error_text(invalid)
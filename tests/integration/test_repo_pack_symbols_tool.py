@@ -0,0 +1,72 @@
+from __future__ import annotations
+
+from pathlib import Path
+
+from tests.helpers import call_tool, extract_result, is_tool_error, tool_error_text
+
+from repo_mcp.server import create_server
+
+
+def _write_repo(root: Path) -> None:
+    (root / "src").mkdir()
+    (root / "src" / "worker.go").write_text(
+        "package worker\n"
+        "\n"
+        "// Build returns a worker.\n"
+        "func Build() {}\n"
+        "\n"
+        "func helper() {}\n",
+        encoding="utf-8",
+    )
+    (root / "src" / "service.py").write_text(
+        "def _private() -> None:\n    pass\n",
+        encoding="utf-8",
+    )
+
+
+def test_repo_pack_symbols_returns_packed_text_and_manifest(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(
+        call_tool(server, "req-pack-1", "repo.pack_symbols", {"max_tokens": 20})
+    )
+
+    assert result["text"] == (
+        "# src/worker.go\nfunction worker.Build() :4\n  Build returns a worker.\n"
+    )
+    assert result["max_tokens"] == 20
+    assert result["estimated_tokens"] == 18
+    assert result["tokenizer"] == "chars/4"
+    assert (result["symbols_included"], result["symbols_total"]) == (1, 3)
+    assert result["files"] == [
+        {"path": "src/service.py", "status": "dropped", "symbols_included": 0, "symbols_total": 1},
+        {"path": "src/worker.go", "status": "partial", "symbols_included": 1, "symbols_total": 2},
+    ]
+
+    full = extract_result(
+        call_tool(
+            server,
+            "req-pack-2",
+            "repo.pack_symbols",
+            {"max_tokens": 1000, "chars_per_token": 1},
+        )
+    )
+    assert full["tokenizer"] == "chars/1"
+    assert full["estimated_tokens"] == len(full["text"])
+    assert [item["status"] for item in full["files"]] == ["full", "full"]
+
+
+def test_repo_pack_symbols_validates_arguments(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    server = create_server(repo_root=str(tmp_path))
+
+    missing = call_tool(server, "req-pack-3", "repo.pack_symbols", {})
+    assert is_tool_error(missing)
+    assert "max_tokens must be an integer between 1 and" in tool_error_text(missing)
+
+    ratio = call_tool(
+        server, "req-pack-4", "repo.pack_symbols", {"max_tokens": 10, "chars_per_token": 0}
+    )
+    assert is_tool_error(ratio)
+    assert "chars_per_token must be an integer between 1 and 16" in tool_error_text(ratio)
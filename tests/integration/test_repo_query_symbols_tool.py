@@ -0,0 +1,149 @@
+from __future__ import annotations
+
+import json
+from pathlib import Path
+
+from tests.helpers import call_tool, extract_result, is_tool_error, tool_error_text
+
+from repo_mcp.server import create_server
+
+
+def _write_repo(root: Path) -> None:
+    (root / "worker").mkdir()
+    (root / "worker" / "worker.go").write_text(
+        "package worker\n"
+        "\n"
+        "// Build returns a configured worker.\n"
+        "func Build(name string) (*Service, error) { return nil, nil }\n"
+        "\n"
+        "func Run() error { return nil }\n"
+        "\n"
+        "func helper() error { return nil }\n"
+        "\n"
+        "type Service struct{}\n",
+        encoding="utf-8",
+    )
+    (root / "worker" / "tasks.py").write_text(
+        "def schedule() -> None:\n    pass\n",
+        encoding="utf-8",
+    )
+
+
+def test_repo_query_symbols_filters_and_reuses_export_shape(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(
+        call_tool(
+            server,
+            "req-query-1",
+            "repo.query_symbols",
+            {"kind": "function", "visibility": "public", "returns": "error", "package": "worker"},
+        )
+    )
+
+    assert result["format"] == "json"
+    assert (result["files_matched"], result["symbol_count"]) == (1, 2)
+    [group] = result["files"]
+    assert group["path"] == "worker/worker.go"
+    assert [symbol["name"] for symbol in group["symbols"]] == ["worker.Build", "worker.Run"]
+    assert group["symbols"][0]["returns"] == ["*Service", "error"]
+    assert set(group) == {"path", "language", "symbols", "imports"}
+
+    markdown = extract_result(
+        call_tool(
+            server,
+            "req-query-2",
+            "repo.query_symbols",
+            {"returns": "None", "format": "markdown"},
+        )
+    )
+    assert markdown["symbol_count"] == 1
+    assert markdown["text"].startswith("# API Overview\n")
+    assert "## worker/tasks.py" in markdown["text"]
+    assert "worker.Build" not in markdown["text"]
+
+
+
+def test_repo_query_symbols_links_packages_like_the_export(tmp_path: Path) -> None:
+    (tmp_path / "a.go").write_text(
+        "package worker\n\ntype Runner interface {\n\tRun()\n}\n\ntype Service struct{}\n",
+        encoding="utf-8",
+    )
+    (tmp_path / "b.go").write_text(
+        "package worker\n\nfunc (s *Service) Run() { s.Stop() }\n\n"
+        "func (s *Service) Stop() {}\n",
+        encoding="utf-8",
+    )
+    server = create_server(repo_root=str(tmp_path))
+
+    queried = extract_result(call_tool(server, "req-query-link-1", "repo.query_symbols", {}))
+    exported = extract_result(call_tool(server, "req-query-link-2", "repo.export_symbols", {}))
+
+    payload = json.loads(Path(exported["artifact_path"]).read_text(encoding="utf-8"))
+    assert queried["files"] == payload["files"]
+    by_name = {
+        symbol["name"]: symbol for group in queried["files"] for symbol in group["symbols"]
+    }
+    assert by_name["worker.Service"]["implements"] == ["*worker.Runner"]
+    assert by_name["worker.Service.Run"]["calls"] == ["worker.Service.Stop"]
+
+
+def test_repo_query_symbols_validates_arguments(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    server = create_server(repo_root=str(tmp_path))
+
+    visibility = call_tool(server, "req-query-3", "repo.query_symbols", {"visibility": "open"})
+    assert is_tool_error(visibility)
+    assert "visibility must be one of: public, private" in tool_error_text(visibility)
+
+    kind = call_tool(server, "req-query-4", "repo.query_symbols", {"kind": ""})
+    assert is_tool_error(kind)
+    assert "kind must be a non-empty string" in tool_error_text(kind)
+
+    fmt = call_tool(server, "req-query-5", "repo.query_symbols", {"format": "jsonl"})
+    assert is_tool_error(fmt)
+    assert "format must be one of: json, markdown" in tool_error_text(fmt)
+
+
+def test_repo_query_symbols_deprecated_filter_lists_migration_targets(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    (tmp_path / "worker" / "legacy.go").write_text(
+        "package worker\n\n// Start starts.\n//\n// Deprecated: use Run.\nfunc Start() {}\n",
+        encoding="utf-8",
+    )
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(
+        call_tool(server, "req-query-dep", "repo.query_symbols", {"deprecated": True})
+    )
+
+    [group] = result["files"]
+    assert [(s["name"], s["deprecation_note"]) for s in group["symbols"]] == [
+        ("worker.Start", "use Run.")
+    ]
+    invalid = call_tool(server, "req-query-dep-2", "repo.query_symbols", {"deprecated": "yes"})
+    assert is_tool_error(invalid)
+    assert "deprecated must be a boolean" in tool_error_text(invalid)
+
+
+def test_repo_query_symbols_min_complexity_lists_branchy_functions(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    (tmp_path / "worker" / "route.go").write_text(
+        "package worker\n\n"
+        "func Route(a, b bool) int {\n\tif a || b {\n\t\treturn 1\n\t}\n\treturn 0\n}\n",
+        encoding="utf-8",
+    )
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(
+        call_tool(server, "req-query-cx", "repo.query_symbols", {"min_complexity": 2})
+    )
+
+    [group] = result["files"]
+    assert [(s["name"], s["complexity"], s["line_count"]) for s in group["symbols"]] == [
+        ("worker.Route", 3, 6)
+    ]
+    invalid = call_tool(server, "req-query-cx-2", "repo.query_symbols", {"min_complexity": 0})
+    assert is_tool_error(invalid)
+    assert "min_complexity must be a positive integer" in tool_error_text(invalid)
@@ -0,0 +1,74 @@
+from __future__ import annotations
+
+from pathlib import Path
+
+from tests.helpers import call_tool, extract_result, is_tool_error, tool_error_text
+
+from repo_mcp.server import create_server
+
+
+def _write_repo(root: Path) -> None:
+    (root / "client").mkdir()
+    (root / "client" / "client.go").write_text(
+        "package client\n"
+        "\n"
+        "// Send posts a request and will retry on 503.\n"
+        "func Send() error { return nil }\n"
+        "\n"
+        "func Retry(attempts int) error { return nil }\n",
+        encoding="utf-8",
+    )
+    (root / "client" / "policy.py").write_text(
+        "class RetryPolicy:\n    pass\n",
+        encoding="utf-8",
+    )
+
+
+def test_repo_search_symbols_returns_ranked_hits_with_locations(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(
+        call_tool(server, "req-ssym-1", "repo.search_symbols", {"query": "retry"})
+    )
+
+    assert (result["query"], result["format"]) == ("retry", "json")
+    assert (result["total_matches"], result["truncated"]) == (3, False)
+    assert [(hit["name"], hit["path"], hit["start_line"]) for hit in result["hits"]] == [
+        ("client.Retry", "client/client.go", 6),
+        ("RetryPolicy", "client/policy.py", 1),
+        ("client.Send", "client/client.go", 4),
+    ]
+    assert result["hits"][2]["matched_fields"] == ["doc"]
+
+    limited = extract_result(
+        call_tool(
+            server,
+            "req-ssym-2",
+            "repo.search_symbols",
+            {"query": "retry", "limit": 1, "format": "markdown"},
+        )
+    )
+    assert (limited["total_matches"], limited["truncated"]) == (3, True)
+    assert limited["text"].splitlines()[2].startswith(
+        "1. `client.Retry` (function) - `client/client.go:6`"
+    )
+    assert "hits" not in limited
+
+
+def test_repo_search_symbols_rejects_invalid_arguments(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    server = create_server(repo_root=str(tmp_path))
+
+    cases = [
+        ({}, "query must be a non-empty string"),
+        ({"query": "  "}, "query must be a non-empty string"),
+        ({"query": "retry", "limit": 0}, "limit must be an integer between 1 and"),
+        ({"query": "retry", "limit": True}, "limit must be an integer between 1 and"),
+        ({"query": "retry", "format": "jsonl"}, "format must be one of: json, markdown"),
+        ({"query": "retry", "skip_tests": "no"}, "skip_tests must be a boolean"),
+    ]
+    for index, (arguments, message) in enumerate(cases):
+        response = call_tool(server, f"req-ssym-e{index}", "repo.search_symbols", arguments)
+        assert is_tool_error(response)
+        assert message in tool_error_text(response)
@@ -0,0 +1,69 @@
+from __future__ import annotations
+
+from pathlib import Path
+
+from tests.helpers import call_tool, extract_result, is_tool_error, tool_error_text
+
+from repo_mcp.server import create_server
+
+
+def _write_repo(root: Path) -> None:
+    (root / "worker").mkdir()
+    (root / "worker" / "worker.go").write_text(
+        "package worker\n"
+        "\n"
+        "type Runner interface {\n"
+        "\tRun() error\n"
+        "}\n"
+        "\n"
+        "type Service struct{}\n"
+        "\n"
+        "func Build() *Service { return &Service{} }\n"
+        "\n"
+        "func helper() {}\n",
+        encoding="utf-8",
+    )
+    (root / "worker" / "worker_test.go").write_text(
+        "package worker\n\nfunc TestBuild(t *testing.T) {}\n",
+        encoding="utf-8",
+    )
+    (root / "README.md").write_text("# Worker\n", encoding="utf-8")
+
+
+def test_repo_summary_reports_aggregate_counts(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(call_tool(server, "req-summary-1", "repo.summary", {}))
+
+    assert (result["format"], result["files_failed"]) == ("json", 0)
+    assert (result["files"], result["lines"]) == (3, 15)
+    [package] = [item for item in result["packages"] if item["package"] == "worker"]
+    assert package["files"] == 2
+    assert package["kinds"] == {"function": 3, "method": 1, "type": 2}
+    assert (package["exported"], package["unexported"]) == (5, 1)
+    assert result["largest_files"][0] == {
+        "path": "worker/worker.go",
+        "language": "go_lexical",
+        "lines": 11,
+    }
+
+    skipped = extract_result(
+        call_tool(server, "req-summary-2", "repo.summary", {"skip_tests": True})
+    )
+    assert skipped["files"] == 2
+
+
+def test_repo_summary_markdown_and_invalid_format(tmp_path: Path) -> None:
+    _write_repo(tmp_path)
+    server = create_server(repo_root=str(tmp_path))
+
+    result = extract_result(
+        call_tool(server, "req-summary-3", "repo.summary", {"format": "markdown"})
+    )
+    assert result["text"].startswith("# Repository Summary\n")
+    assert "| worker/worker.go | go_lexical | 11 |" in result["text"]
+
+    invalid = call_tool(server, "req-summary-4", "repo.summary", {"format": "csv"})
+    assert is_tool_error(invalid)
+    assert "format must be one of: json, markdown" in tool_error_text(invalid)
@@ -51,7 +51,7 @@ def test_tool_contract_matrix_for_required_v1_tools(tmp_path: Path) -> None:
         if tool_name == "repo.search":
             assert "hits" in result
         if tool_name == "repo.outline":
-            assert set(result.keys()) == {"path", "language", "symbols"}
+            assert set(result.keys()) == {"path", "language", "symbols", "imports"}
             assert result["symbols"]
             assert set(result["symbols"][0].keys()) == {
                 "kind",
@@ -64,6 +64,38 @@ def test_tool_contract_matrix_for_required_v1_tools(tmp_path: Path) -> None:
                 "scope_kind",
                 "is_conditional",
                 "decl_context",
+                "decorators",
+                "visibility",
+                "start_col",
+                "tag",
+                "tags",
+                "calls",
+                "returns",
+                "is_test",
+                "role",
+                "implements",
+                "package",
+                "qualified_name",
+                "deprecated",
+                "deprecation_note",
+                "value",
+                "value_type",
+                "iota_value",
+                "id",
+                "access",
+                "build_constraints",
+                "spawns_goroutine",
+                "uses_channels",
+                "takes_context",
+                "accessors",
+                "partial",
+                "receiver",
+                "receiver_name",
+                "references",
+                "complexity",
+                "line_count",
+                "examples",
+                "canonical_signature",
             }
         if tool_name == "repo.build_context_bundle":
             assert set(result.keys()) == {
@@ -0,0 +1,43 @@
+from __future__ import annotations
+
+import io
+import json
+from pathlib import Path
+
+from repo_mcp.server import create_server
+
+
+def test_watch_re_exports_changed_files_and_prunes_deleted_ones(tmp_path: Path) -> None:
+    (tmp_path / "src").mkdir()
+    (tmp_path / "src" / "worker.go").write_text("package worker\n\nfunc Build() {}\n", "utf-8")
+    (tmp_path / "src" / "old.go").write_text("package worker\n\nfunc Old() {}\n", "utf-8")
+    (tmp_path / "repo_mcp.toml").write_text("[scan]\nwatch_debounce_ms = 1\n", "utf-8")
+    server = create_server(repo_root=str(tmp_path))
+    sleeps: list[float] = []
+
+    def sleep(seconds: float) -> None:
+        sleeps.append(seconds)
+        if len(sleeps) == 2:
+            (tmp_path / "src" / "worker.go").write_text(
+                "package worker\n\nfunc Build() {}\n\nfunc Stop() {}\n", "utf-8"
+            )
+            (tmp_path / "src" / "old.go").unlink()
+
+    out = io.StringIO()
+    server.watch(out, poll_seconds=0.0, should_stop=lambda: len(sleeps) >= 6, sleep=sleep)
+
+    events = [json.loads(line) for line in out.getvalue().splitlines()]
+    assert [(event["event"], event["symbol_count"]) for event in events] == [
+        ("initial", 2),
+        ("update", 2),
+    ]
+    assert events[1]["changed"] == ["src/worker.go"]
+    assert events[1]["removed"] == ["src/old.go"]
+    assert (events[1]["files_scanned"], events[1]["files_cached"]) == (2, 1)
+    exported = json.loads(Path(events[1]["artifact_path"]).read_text("utf-8"))
+    assert [group["path"] for group in exported["files"]] == ["src/worker.go"]
+    cache = json.loads((tmp_path / ".repo_mcp" / "cache" / "symbols.json").read_text("utf-8"))
+    assert [entry["record"]["path"] for entry in cache["files"]] == [
+        "repo_mcp.toml",
+        "src/worker.go",
+    ]
@@ -13,6 +13,7 @@ def test_required_package_paths_exist() -> None:
         "src/repo_mcp/bundler/__init__.py",
         "src/repo_mcp/security/__init__.py",
         "src/repo_mcp/logging/__init__.py",
+        "src/repo_mcp/symbols/__init__.py",
     ]
     for rel in required:
         assert (root / rel).exists(), rel
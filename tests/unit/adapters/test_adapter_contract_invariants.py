@@ -1,5 +1,7 @@
 from __future__ import annotations
 
+from dataclasses import replace
+
 import pytest
 
 from repo_mcp.adapters import (
@@ -7,6 +9,7 @@ from repo_mcp.adapters import (
     OutlineSymbol,
     normalize_and_sort_symbols,
     normalize_signature,
+    symbol_id,
     validate_outline_symbols,
 )
 
@@ -182,3 +185,29 @@ def test_normalize_and_sort_symbols_infers_default_metadata_for_lexical_symbols(
     assert by_name["pkg.Service.run"].parent_symbol == "pkg.Service"
     assert by_name["pkg.build"].scope_kind == "module"
     assert by_name["pkg.build"].parent_symbol is None
+
+
+def test_normalize_and_sort_symbols_assigns_ids_that_ignore_line_numbers() -> None:
+    method = OutlineSymbol(
+        kind="method",
+        name="worker.Service.Run",
+        signature="()",
+        start_line=10,
+        end_line=12,
+        doc=None,
+        package="worker",
+        qualified_name="worker.Service.Run",
+    )
+    moved = replace(method, start_line=40, end_line=44, doc="Run runs.")
+    renamed_kind = replace(method, kind="function")
+
+    ids = {
+        (symbol.kind, symbol.start_line): symbol.id
+        for symbol in normalize_and_sort_symbols([method, moved, renamed_kind])
+    }
+
+    assert ids[("method", 10)] == ids[("method", 40)] == "04ab76d7471e0adc"
+    assert ids[("function", 10)] == symbol_id("function", "worker", "worker.Service.Run")
+    assert ids[("function", 10)] != ids[("method", 10)]
+    unpackaged = normalize_and_sort_symbols([replace(method, package=None, qualified_name=None)])
+    assert unpackaged[0].id == symbol_id("method", None, "worker.Service.Run")
@@ -59,3 +59,37 @@ def test_cpp_outline_is_deterministic_for_repeated_calls() -> None:
     assert [(s.kind, s.name, s.start_line, s.end_line) for s in first] == [
         (s.kind, s.name, s.start_line, s.end_line) for s in second
     ]
+
+
+def test_cpp_outline_reports_visibility_from_access_sections() -> None:
+    adapter = CppLexicalAdapter()
+    source = (
+        "namespace engine {\n"
+        "class Store {\n"
+        "    void flush();\n"
+        "protected:\n"
+        "    void grow();\n"
+        "public:\n"
+        "    int size() const;\n"
+        "};\n"
+        "struct Point {\n"
+        "    int norm() const;\n"
+        "private: void reset();\n"
+        "};\n"
+        "int area(int side);\n"
+        "}\n"
+    )
+
+    symbols = adapter.outline("src/store.cpp", source)
+
+    assert [(s.name, s.visibility, s.access) for s in symbols] == [
+        ("engine", "public", None),
+        ("Store", "public", None),
+        ("Store.flush", "private", "private"),
+        ("Store.grow", "private", "protected"),
+        ("Store.size", "public", "public"),
+        ("Point", "public", None),
+        ("Point.norm", "public", "public"),
+        ("Point.reset", "private", "private"),
+        ("area", "public", None),
+    ]
@@ -58,3 +58,79 @@ def test_csharp_outline_is_deterministic_on_repeated_calls() -> None:
     assert [(s.kind, s.name, s.start_line, s.end_line) for s in first] == [
         (s.kind, s.name, s.start_line, s.end_line) for s in second
     ]
+
+
+def test_csharp_outline_assigns_namespace_package_without_repeating_it() -> None:
+    adapter = CSharpLexicalAdapter()
+    source = (
+        "namespace Acme.Jobs;\n"
+        "\n"
+        "public class Worker\n"
+        "{\n"
+        "    public void Run() { }\n"
+        "}\n"
+    )
+
+    symbols = adapter.outline("src/Worker.cs", source)
+
+    assert [(symbol.name, symbol.package, symbol.qualified_name) for symbol in symbols] == [
+        ("Acme.Jobs", None, "Acme.Jobs"),
+        ("Acme.Jobs.Worker", "Acme.Jobs", "Acme.Jobs.Worker"),
+        ("Acme.Jobs.Worker.Run", "Acme.Jobs", "Acme.Jobs.Worker.Run"),
+    ]
+
+
+def test_csharp_outline_extracts_fields_accessors_access_and_attributes() -> None:
+    adapter = CSharpLexicalAdapter()
+
+    symbols = adapter.outline("src/Invoice.cs", _fixture_text("members.cs"))
+
+    by_name = {symbol.name: symbol for symbol in symbols}
+    assert [name for name in by_name if by_name[name].kind == "field"] == [
+        "Acme.Billing.Invoice.MaxLines",
+        "Acme.Billing.Invoice._currency",
+        "Acme.Billing.Invoice._number",
+        "Acme.Billing.Invoice.Rate",
+        "Acme.Billing.Invoice.total",
+    ]
+    assert by_name["Acme.Billing.Invoice._number"].signature == "string"
+    assert {
+        name: symbol.accessors for name, symbol in by_name.items() if symbol.kind == "property"
+    } == {
+        "Acme.Billing.Invoice.Number": ("get", "private set"),
+        "Acme.Billing.Invoice.Total": ("get", "protected set"),
+        "Acme.Billing.Invoice.IsEmpty": ("get",),
+        "Acme.Billing.Invoice.Line.Quantity": ("get", "init"),
+    }
+    assert by_name["Acme.Billing.Invoice.Total"].end_line == 19
+    assert {name: (symbol.access, symbol.visibility) for name, symbol in by_name.items()} == {
+        "Acme.Billing": (None, "public"),
+        "Acme.Billing.Invoice": ("public", "public"),
+        "Acme.Billing.Invoice.MaxLines": ("public", "public"),
+        "Acme.Billing.Invoice._currency": ("private", "private"),
+        "Acme.Billing.Invoice._number": ("private", "private"),
+        "Acme.Billing.Invoice.Rate": ("internal", "private"),
+        "Acme.Billing.Invoice.total": ("private", "private"),
+        "Acme.Billing.Invoice.Number": ("public", "public"),
+        "Acme.Billing.Invoice.Total": ("public", "public"),
+        "Acme.Billing.Invoice.IsEmpty": ("public", "public"),
+        "Acme.Billing.Invoice.Paid": ("public", "public"),
+        "Acme.Billing.Invoice.Recalculate": ("protected internal", "private"),
+        "Acme.Billing.Invoice.OnPaid": ("private", "private"),
+        "Acme.Billing.Invoice.Line": ("private", "private"),
+        "Acme.Billing.Invoice.Line.Quantity": ("public", "public"),
+        "Acme.Billing.ILedger": ("internal", "private"),
+        "Acme.Billing.ILedger.Post": ("public", "public"),
+        "Acme.Billing.Status": ("internal", "private"),
+    }
+
+    invoice = by_name["Acme.Billing.Invoice"]
+    assert invoice.decorators == ("Serializable", 'Obsolete("Use InvoiceV2 instead.")')
+    assert (invoice.deprecated, invoice.deprecation_note) == (True, "Use InvoiceV2 instead.")
+    assert invoice.partial is True
+    assert by_name["Acme.Billing.Invoice.Line"].partial is False
+    recalculate = by_name["Acme.Billing.Invoice.Recalculate"]
+    assert (recalculate.decorators, recalculate.deprecated) == (("Obsolete",), True)
+    assert recalculate.deprecation_note is None
+    assert by_name["Acme.Billing.Invoice.Line"].parent_symbol == "Acme.Billing.Invoice"
+    assert by_name["Acme.Billing.Invoice.Line.Quantity"].package == "Acme.Billing"
@@ -16,3 +16,4 @@ def test_fallback_adapter_optional_hooks_return_none_or_empty() -> None:
 
     assert adapter.smart_chunks("a.txt", "one\ntwo\n") is None
     assert adapter.symbol_hints("find parser function") == ()
+    assert adapter.diagnostics("a.txt", "{\n") is None
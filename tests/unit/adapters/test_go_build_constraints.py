@@ -0,0 +1,45 @@
+from __future__ import annotations
+
+from repo_mcp.adapters.go_build import (
+    go_constraints_match,
+    go_file_constraints,
+    resolve_go_target,
+)
+
+
+def test_go_file_constraints_reads_filename_suffixes() -> None:
+    assert go_file_constraints("sys_windows.go", []) == ("windows",)
+    assert go_file_constraints("asm_arm64.go", []) == ("arm64",)
+    assert go_file_constraints("zsys_linux_386_test.go", []) == ("linux", "386")
+    assert go_file_constraints("linux.go", []) == ()
+    assert go_file_constraints("poll_server.go", []) == ()
+
+
+def test_go_file_constraints_converts_legacy_plus_build_lines() -> None:
+    lines = ["// +build linux,amd64 darwin", "// +build !purego", "", "package crypto"]
+
+    assert go_file_constraints("src/sum.go", lines) == (
+        "(linux && amd64 || darwin) && !purego",
+    )
+    lines_after_package = ["package crypto", "", "//go:build linux"]
+    assert go_file_constraints("src/sum.go", lines_after_package) == ()
+
+
+def test_go_constraints_match_evaluates_target_tags() -> None:
+    assert go_constraints_match(("linux", "amd64"), "linux", "amd64")
+    assert not go_constraints_match(("windows",), "linux", "amd64")
+    assert go_constraints_match(("unix && !wasm",), "darwin", "arm64")
+    assert not go_constraints_match(("unix",), "windows", "amd64")
+    assert go_constraints_match(("linux",), "android", "arm64")
+    assert go_constraints_match(("go1.21 && gc",), "linux", "amd64")
+    assert not go_constraints_match(("cgo || integration",), "linux", "amd64")
+    assert go_constraints_match(("!(windows || plan9)",), "openbsd", "amd64")
+    assert go_constraints_match(("linux &&",), "windows", "amd64")
+
+
+def test_resolve_go_target_defaults_missing_half_to_host() -> None:
+    assert resolve_go_target(None, None) is None
+    assert resolve_go_target("windows", "arm64") == ("windows", "arm64")
+    goos, goarch = resolve_go_target("plan9", None) or ("", "")
+    assert goos == "plan9"
+    assert goarch
@@ -2,7 +2,7 @@ from __future__ import annotations
 
 from pathlib import Path
 
-from repo_mcp.adapters import GoLexicalAdapter
+from repo_mcp.adapters import GoLexicalAdapter, ParseDiagnostic, go
 
 
 def _fixture_text(name: str) -> str:
@@ -39,6 +39,8 @@ def test_go_outline_extracts_package_types_funcs_methods_consts_vars() -> None:
     assert by_name["worker.Build"].signature == "(name string)"
     assert by_name["worker.Service.Run"].kind == "method"
     assert by_name["worker.Service.Run"].signature == "(ctx context.Context)"
+    assert by_name["worker.GlobalEnabled"].visibility == "public"
+    assert by_name["worker.globalVersion"].visibility == "private"
 
     assert all(symbol.end_line >= symbol.start_line for symbol in symbols)
 
@@ -53,3 +55,505 @@ def test_go_outline_is_deterministic_on_repeated_calls() -> None:
     assert [(s.kind, s.name, s.start_line, s.end_line) for s in first] == [
         (s.kind, s.name, s.start_line, s.end_line) for s in second
     ]
+
+
+def test_go_outline_extracts_doc_comments() -> None:
+    adapter = GoLexicalAdapter()
+    source = _fixture_text("sample.go")
+
+    by_name = {symbol.name: symbol for symbol in adapter.outline("src/sample.go", source)}
+
+    assert by_name["worker.Runner"].doc == "Runner executes one unit of work."
+    assert by_name["worker.Service"].doc == (
+        "Service holds worker configuration.\n\nThe zero value is not usable; call Build."
+    )
+    assert by_name["worker.Build"].doc == "Build returns a Service with the given name."
+    assert by_name["worker.DefaultName"].doc == "Defaults shared by every service."
+    assert by_name["worker.MaxRetries"].doc == "MaxRetries bounds the retry loop."
+    assert by_name["worker.GlobalEnabled"].doc == "toggled by tests"
+    assert by_name["worker.globalVersion"].doc is None
+    assert by_name["worker.Service.Run"].doc is None
+
+
+def test_go_outline_doc_ignores_comments_separated_by_blank_line() -> None:
+    adapter = GoLexicalAdapter()
+    source = (
+        "package demo\n"
+        "\n"
+        "// Detached comment.\n"
+        "\n"
+        "func Loose() {}\n"
+        "\n"
+        'var url = "http://example.com" // endpoint\n'
+    )
+
+    by_name = {symbol.name: symbol for symbol in adapter.outline("demo.go", source)}
+
+    assert by_name["demo.Loose"].doc is None
+    assert by_name["demo.url"].doc == "endpoint"
+
+
+def test_go_outline_preserves_generic_type_parameters_in_signatures() -> None:
+    adapter = GoLexicalAdapter()
+    source = _fixture_text("generics.go")
+
+    by_name = {symbol.name: symbol for symbol in adapter.outline("src/generics.go", source)}
+
+    assert by_name["collections.Stack"].signature == "[T comparable]"
+    assert by_name["collections.Pair"].signature == "[K comparable, V any]"
+    assert by_name["collections.Grid"].signature is None
+    assert by_name["collections.Map"].signature == "[T any, U any](in []T, f func(T) U)"
+    assert by_name["collections.Max"].signature == "[T constraints.Ordered](a, b T)"
+    assert by_name["collections.Stack.Push"].kind == "method"
+    assert by_name["collections.Stack.Push"].signature == "(value T)"
+    assert by_name["collections.Reduce"].signature == (
+        "[T, A any](in []T, initial A, step func(A, T) A)"
+    )
+    assert by_name["collections.Reduce"].start_line == 33
+    assert by_name["collections.Reduce"].end_line == 43
+
+
+def test_go_outline_emits_interface_methods_and_struct_fields_as_children() -> None:
+    adapter = GoLexicalAdapter()
+
+    sample = {s.name: s for s in adapter.outline("src/sample.go", _fixture_text("sample.go"))}
+    assert sample["worker.Runner.Run"].kind == "method"
+    assert sample["worker.Runner.Run"].signature == "(ctx context.Context)"
+    assert sample["worker.Runner.Run"].parent_symbol == "worker.Runner"
+    assert sample["worker.Service.name"].kind == "field"
+    assert sample["worker.Service.name"].signature == "string"
+    assert sample["worker.Service.name"].scope_kind == "class"
+
+    symbols = adapter.outline("src/generics.go", _fixture_text("generics.go"))
+    children = [
+        (s.kind, s.name, s.signature, s.start_line, s.end_line, s.doc)
+        for s in symbols
+        if s.parent_symbol in {"collections.ReadCloserStack", "collections.Node"}
+    ]
+    assert children == [
+        ("embedded", "collections.ReadCloserStack.Reader", "io.Reader", 47, 47, None),
+        ("method", "collections.ReadCloserStack.Pop", "()", 49, 49, "Pop removes the top item."),
+        ("method", "collections.ReadCloserStack.Peek", "(depth int)", 50, 52, None),
+        ("embedded", "collections.Node.Stack", "*Stack[T]", 57, 57, None),
+        ("embedded", "collections.Node.Mutex", "sync.Mutex", 58, 58, None),
+        ("field", "collections.Node.Left", "*Node[T]", 59, 59, None),
+        ("field", "collections.Node.Right", "*Node[T]", 59, 59, None),
+        ("field", "collections.Node.value", "T", 60, 60, "stored item"),
+        ("field", "collections.Node.meta", "struct", 61, 63, None),
+    ]
+
+
+def test_go_outline_records_name_columns_for_declarations_and_members() -> None:
+    adapter = GoLexicalAdapter()
+    text = (
+        "package worker\n"
+        "\n"
+        "type Service struct {\n"
+        "\tLeft, Right *Service\n"
+        "}\n"
+        "\n"
+        "func (Service) Service() {}\n"
+        "\n"
+        "const (\n"
+        "    Limit = 3\n"
+        ")\n"
+    )
+
+    columns = {s.name: (s.start_line, s.start_col) for s in adapter.outline("src/w.go", text)}
+    assert columns == {
+        "worker.Service": (3, 6),
+        "worker.Service.Left": (4, 2),
+        "worker.Service.Right": (4, 8),
+        "worker.Service.Service": (7, 16),
+        "worker.Limit": (10, 5),
+    }
+
+
+def test_go_outline_attaches_struct_tags_to_their_fields() -> None:
+    adapter = GoLexicalAdapter()
+    symbols = adapter.outline("src/tags.go", _fixture_text("tags.go"))
+
+    by_name = {s.name: s for s in symbols}
+    tags = {
+        s.name.rsplit(".", 1)[-1]: dict(s.tags) if s.tags else None
+        for s in symbols
+        if s.parent_symbol == "api.Service"
+    }
+    assert tags == {
+        "Base": {"json": ",inline"},
+        "Owner": {"json": "owner,omitempty"},
+        "Name": {"json": "name", "validate": "required"},
+        "Port": {"json": "port,omitempty", "validate": "min=1,max=65535"},
+        "private": None,
+        "Labels": {"json": "labels"},
+        "Config": {"json": "config"},
+        "Legacy": None,
+        "Mixed": {"json": "mixed"},
+        "Retries": {"json": "retries"},
+    }
+    assert by_name["api.Service.Legacy"].tag == "custom format; not key:value"
+    assert by_name["api.Service.Mixed"].tag == 'json:"mixed" broken'
+    assert by_name["api.Service.Retries"].doc == "Retries bounds reconnects."
+    assert by_name["api.Base.CreatedAt"].tags == (("json", "created_at"), ("db", "created_at"))
+    assert by_name["api.Service"].tag is None
+
+
+def test_go_outline_records_call_edges_for_functions_and_methods() -> None:
+    adapter = GoLexicalAdapter()
+    symbols = adapter.outline("src/calls.go", _fixture_text("calls.go"))
+
+    calls = {s.name: s.calls for s in symbols if s.calls is not None}
+    assert calls == {
+        "graph.NewNode": ("str.TrimSpace",),
+        "graph.Node.Link": ("graph.Node.validate",),
+        "graph.Node.validate": ("fmt.Sprintf",),
+        "graph.Walk": ("visit", "graph.Walk", "fmt.Println"),
+        "graph.Build": ("graph.NewNode", "root.Link", "graph.Walk", "n.validate"),
+    }
+    assert all(s.calls is None for s in symbols if s.kind in {"type", "field"})
+
+
+def test_go_imports_report_alias_and_usage() -> None:
+    adapter = GoLexicalAdapter()
+    imports = adapter.imports("src/imports.go", _fixture_text("imports.go"))
+
+    assert imports is not None
+    assert [(i.line, i.path, i.alias, i.used) for i in imports] == [
+        (3, "errors", None, True),
+        (6, "fmt", None, True),
+        (7, "embed", "_", None),
+        (8, "math", ".", None),
+        (9, "gopkg.in/yaml.v3", "yaml", True),
+        (10, "github.com/example/api/v2", None, True),
+        (11, "net/http", None, False),
+    ]
+    assert all(i.name is None for i in imports)
+
+
+def test_go_outline_parses_result_types() -> None:
+    adapter = GoLexicalAdapter()
+    symbols = adapter.outline("src/results.go", _fixture_text("results.go"))
+
+    returns = {s.name: s.returns for s in symbols}
+    assert returns == {
+        "worker.Store": None,
+        "worker.Store.Load": ("[]byte", "error"),
+        "worker.Ping": (),
+        "worker.Open": ("*Store", "error"),
+        "worker.Split": ("string", "string", "error"),
+        "worker.Empty": ("interface{}",),
+        "worker.Lines": ("[]string", "error"),
+    }
+
+
+def test_go_outline_flags_test_files_and_test_function_roles() -> None:
+    adapter = GoLexicalAdapter()
+    symbols = adapter.outline("pkg/worker_test.go", _fixture_text("worker_test.go"))
+
+    assert all(s.is_test is True for s in symbols)
+    roles = {s.name.rsplit(".", 1)[-1]: s.role for s in symbols if s.role is not None}
+    assert roles == {
+        "TestBuild": "test",
+        "Test_edgeCase": "test",
+        "BenchmarkBuild": "benchmark",
+        "FuzzParse": "fuzz",
+        "Example": "example",
+        "ExampleBuild": "example",
+        "ExampleService_Run": "example",
+        "Example_suffix": "example",
+    }
+
+    production = adapter.outline("pkg/worker.go", _fixture_text("worker_test.go"))
+    assert all(s.is_test is False and s.role is None for s in production)
+
+
+def test_go_outline_infers_interface_implementations_from_method_sets() -> None:
+    adapter = GoLexicalAdapter()
+    source = _fixture_text("implements.go")
+
+    symbols = adapter.outline("src/implements.go", source)
+    implements = {s.name: s.implements for s in symbols if s.kind == "type"}
+
+    assert implements["shapes.Base"] == ("shapes.Namer",)
+    assert implements["shapes.Service"] == ("shapes.Namer", "*shapes.RunNamer", "*shapes.Runner")
+    assert implements["shapes.Job"] == ("*shapes.Namer", "*shapes.RunNamer", "shapes.Runner")
+    assert implements["shapes.Celsius"] == ("shapes.Namer",)
+    assert implements["shapes.Broken"] is None
+    assert implements["shapes.Runner"] is None
+    assert all("shapes.ReadNamer" not in (v or ()) for v in implements.values())
+    assert all("shapes.Number" not in (v or ()) for v in implements.values())
+
+
+def test_go_outline_flags_deprecated_paragraph_in_doc_comment() -> None:
+    adapter = GoLexicalAdapter()
+    source = (
+        "package worker\n"
+        "\n"
+        "// Build builds a worker.\n"
+        "//\n"
+        "// Deprecated: use NewWorker, which\n"
+        "// validates its options.\n"
+        "//\n"
+        "// Build keeps working until v2.\n"
+        "func Build() {}\n"
+        "\n"
+        "// Run runs the worker.\n"
+        "func Run() {}\n"
+    )
+
+    symbols = {symbol.name: symbol for symbol in adapter.outline("src/worker.go", source)}
+
+    assert symbols["worker.Build"].deprecated is True
+    assert symbols["worker.Build"].deprecation_note == (
+        "use NewWorker, which validates its options."
+    )
+    assert (symbols["worker.Run"].deprecated, symbols["worker.Run"].deprecation_note) == (
+        False,
+        None,
+    )
+
+
+def test_go_outline_captures_const_and_var_values_types_and_iota() -> None:
+    adapter = GoLexicalAdapter()
+    source = _fixture_text("consts.go")
+
+    symbols = adapter.outline("src/consts.go", source)
+
+    values = {
+        symbol.name: (symbol.value, symbol.value_type, symbol.iota_value)
+        for symbol in symbols
+        if symbol.kind in {"const", "var"}
+    }
+    assert values == {
+        "config.Fast": ("iota", "Mode", 0),
+        "config.Slow": ("iota", "Mode", 1),
+        "config._": ("iota", "Mode", 2),
+        "config.Paused": ("iota", "Mode", 3),
+        "config.KB": ("1 << (10 * (iota + 1))", "int", 1024),
+        "config.MB": ("1 << (10 * (iota + 1))", "int", 1048576),
+        "config.GB": ("1 << (10 * (iota + 1))", "int", 1073741824),
+        "config.DefaultName": ('"svc"', "string", None),
+        "config.Ratio": ("1.5", "float64", None),
+        "config.Timeout": ("5 * time.Second", "time.Duration", None),
+        "config.Separator": ("','", "rune", None),
+        "config.MaxAttempts": ("9", "int64", None),
+        "config.Enabled": ("true", "bool", None),
+        "config.cache": ("map[string]int{}", "map[string]int", None),
+        "config.client": ('&Client{name: "default"}', "*Client", None),
+        "config.host": ('"localhost"', "string", None),
+        "config.counter": (None, "int", None),
+        "config.ErrClosed": ('errors.New("closed")', None, None),
+    }
+    type_symbol = next(symbol for symbol in symbols if symbol.name == "config.Mode")
+    assert (type_symbol.value, type_symbol.value_type, type_symbol.iota_value) == (
+        None,
+        None,
+        None,
+    )
+
+
+def test_go_outline_records_filename_and_header_build_constraints() -> None:
+    adapter = GoLexicalAdapter()
+    source = _fixture_text("constraints.go")
+
+    symbols = adapter.outline("internal/poller/wait_linux_amd64.go", source)
+
+    assert {symbol.build_constraints for symbol in symbols} == {
+        ("linux", "amd64", "(linux || darwin) && !cgo")
+    }
+    plain = adapter.outline("src/sample.go", _fixture_text("sample.go"))
+    assert {symbol.build_constraints for symbol in plain} == {()}
+
+
+def test_go_diagnostics_report_unclosed_braces_and_missing_package() -> None:
+    adapter = GoLexicalAdapter()
+
+    assert adapter.diagnostics("src/sample.go", _fixture_text("sample.go")) == []
+    assert adapter.diagnostics("src/bad.go", "func Run() {\n\tgo func() {\n}\n") == [
+        ParseDiagnostic(line=None, message="missing package clause"),
+        ParseDiagnostic(line=1, message="'{' is never closed (1 unclosed)"),
+    ]
+
+
+def test_go_outline_flags_goroutines_channels_and_context_parameters() -> None:
+    adapter = GoLexicalAdapter()
+
+    symbols = adapter.outline("pool/concurrency.go", _fixture_text("concurrency.go"))
+
+    flags = {
+        symbol.name: (symbol.spawns_goroutine, symbol.uses_channels, symbol.takes_context)
+        for symbol in symbols
+    }
+    assert flags == {
+        "pool.Fanout": (True, True, True),
+        "pool.drain": (False, True, False),
+        "pool.Spawn": (True, False, True),
+        "pool.Pool.Wait": (False, False, False),
+        "pool.Pool": (None, None, None),
+        "pool.Pool.gopher": (False, False, False),
+    }
+
+
+def test_go_outline_records_method_receivers_including_generic_types() -> None:
+    adapter = GoLexicalAdapter()
+    source = (
+        "package shapes\n\n"
+        "type List[K comparable, V any] struct{}\n\n"
+        "func (l *List[K, V]) Len() int { return 0 }\n\n"
+        "func (p Point) Norm() float64 { return 0 }\n\n"
+        "func (*Point) Reset() {}\n\n"
+        "func Area() float64 { return 0 }\n"
+    )
+
+    symbols = adapter.outline("shapes/shapes.go", source)
+
+    receivers = {
+        symbol.name: (symbol.receiver, symbol.parent_symbol)
+        for symbol in symbols
+        if symbol.kind in {"method", "function"}
+    }
+    assert receivers == {
+        "shapes.List.Len": ("*List[K, V]", "shapes.List"),
+        "shapes.Point.Norm": ("Point", "shapes.Point"),
+        "shapes.Point.Reset": ("*Point", "shapes.Point"),
+        "shapes.Area": (None, None),
+    }
+
+
+def test_go_outline_records_type_references_in_signatures_and_fields() -> None:
+    adapter = GoLexicalAdapter()
+
+    symbols = adapter.outline("worker/typerefs.go", _fixture_text("typerefs.go"))
+
+    references = {
+        symbol.name: symbol.references for symbol in symbols if symbol.references is not None
+    }
+    assert references == {
+        "worker.Store.items": (),
+        "worker.Store.next": ("worker.Store",),
+        "worker.Store.Reader": ("io.Reader",),
+        "worker.Store.hook": ("context.Context", "worker.Service"),
+        "worker.Runner.Run": ("context.Context", "worker.Result"),
+        "worker.Store.Get": (),
+        "worker.Build": ("fmt.Stringer", "worker.Service", "github.com/acme/logging/v2.Logger"),
+        "worker.Plain": ("worker.Item", "worker.Event", "worker.Result"),
+    }
+
+
+def test_go_outline_records_canonical_signatures() -> None:
+    adapter = GoLexicalAdapter()
+
+    written = adapter.outline("worker/signatures.go", _fixture_text("signatures.go"))
+    restyled = adapter.outline(
+        "worker/signatures_restyled.go", _fixture_text("signatures_restyled.go")
+    )
+
+    canonical = {symbol.name: symbol.canonical_signature for symbol in written}
+    assert canonical == {
+        "worker.Copy": "(dst []byte, src []byte, n int) (int, error)",
+        "worker.Pair": "[K comparable, V comparable](key K, value V) map[K]V",
+        "worker.Open": (
+            "(ctx context.Context, name string) (*github.com/acme/logging/v2.Logger, error)"
+        ),
+        "worker.Reader": None,
+        "worker.Reader.Read": "(ctx context.Context, from int, to int) (int, error)",
+        "worker.Drain": "(ch <-chan []byte, fn func(x, y int) error) error",
+    }
+    assert {symbol.name: symbol.canonical_signature for symbol in restyled} == canonical
+    by_name = {symbol.name: symbol for symbol in written}
+    assert by_name["worker.Copy"].signature == "(dst, src []byte, n int)"
+    assert by_name["worker.Open"].returns == ("*log.Logger", "error")
+
+
+def test_go_outline_counts_decision_points_and_lines_per_function() -> None:
+    adapter = GoLexicalAdapter()
+    source = (
+        "package triage\n\n"
+        "func Flat() {}\n\n"
+        "// Route has if, else if, for, case, case, &&, and a literal's ||.\n"
+        "func Route(a, b bool, xs []int) string {\n"
+        '\tif a && b {\n\t\treturn "if for case"\n\t} else if b {\n'
+        "\t\tfor range xs {\n\t\t}\n\t}\n"
+        "\tswitch len(xs) {\n\tcase 0:\n\tcase 1:\n\tdefault:\n\t}\n"
+        "\tok := func() bool { return a || b }\n"
+        '\t_ = ok // if for\n\treturn ""\n}\n\n'
+        "type T struct{}\n\n"
+        "func (t *T) Select(c chan int) {\n\tselect {\n\tcase <-c:\n\t}\n}\n"
+    )
+
+    symbols = adapter.outline("triage/triage.go", source)
+
+    metrics = {symbol.name: (symbol.complexity, symbol.line_count) for symbol in symbols}
+    assert metrics == {
+        "triage.Flat": (1, 1),
+        "triage.Route": (8, 16),
+        "triage.T": (None, None),
+        "triage.T.Select": (2, 5),
+    }
+
+
+def test_go_outline_records_example_bodies_and_doc_comment_examples() -> None:
+    adapter = GoLexicalAdapter()
+    source = (
+        "package worker\n\n"
+        "// Parse reads a spec.\n"
+        "//\n"
+        "// Example:\n"
+        "//\n"
+        '//\tspec, err := Parse("a=1")\n'
+        "//\tif err != nil {\n"
+        "//\t\treturn err\n"
+        "//\t}\n"
+        "//\n"
+        "// Parse never panics.\n"
+        "func Parse(text string) (Spec, error) { return Spec{}, nil }\n"
+    )
+
+    [parse] = adapter.outline("worker/parse.go", source)
+    tests = adapter.outline("worker/worker_test.go", _fixture_text("worker_test.go"))
+
+    assert parse.examples == ('spec, err := Parse("a=1")\nif err != nil {\n\treturn err\n}',)
+    examples = {s.name: s.examples for s in tests if s.examples is not None}
+    assert examples == {
+        "worker.ExampleBuild": (
+            'svc := Build("indexer")\nfmt.Println(svc.name)\n// Output: indexer',
+        ),
+        "worker.ExampleService_Run": ('_ = Build("indexer").Run(context.Background())',),
+    }
+    production = adapter.outline("worker/worker.go", _fixture_text("worker_test.go"))
+    assert all(s.examples is None for s in production)
+
+
+def test_go_outline_kinds_keeps_allowlisted_symbols_identical_to_outline() -> None:
+    adapter = GoLexicalAdapter()
+    allowlists = (
+        frozenset({"type"}),
+        frozenset({"function"}),
+        frozenset({"method"}),
+        frozenset({"field", "embedded"}),
+        frozenset({"const", "var"}),
+    )
+    for fixture in sorted(Path("tests/fixtures/adapters/go").glob("*.go")):
+        source = fixture.read_text(encoding="utf-8")
+        path = f"src/{fixture.name}"
+        full = adapter.outline(path, source)
+        top_level = {symbol.name for symbol in full if symbol.scope_kind != "class"}
+        for kinds in allowlists:
+            narrowed = adapter.outline_kinds(path, source, kinds)
+            assert [symbol for symbol in narrowed if symbol.kind in kinds] == [
+                symbol for symbol in full if symbol.kind in kinds
+            ], (fixture.name, kinds)
+            assert top_level <= {symbol.name for symbol in narrowed}, (fixture.name, kinds)
+
+
+def test_go_outline_kinds_skips_member_extraction_for_excluded_kinds(monkeypatch) -> None:
+    def fail(**_: object) -> None:
+        raise AssertionError("members extracted")
+
+    monkeypatch.setattr(go, "_extract_type_members", fail)
+    source = _fixture_text("implements.go")
+
+    symbols = GoLexicalAdapter().outline_kinds("src/implements.go", source, frozenset({"const"}))
+
+    assert {symbol.kind for symbol in symbols} == {"method", "type"}
+    assert all(symbol.canonical_signature is None for symbol in symbols)
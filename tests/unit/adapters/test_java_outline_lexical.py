@@ -52,3 +52,57 @@ def test_java_outline_is_deterministic_for_repeated_calls() -> None:
     assert [(s.kind, s.name, s.start_line, s.end_line) for s in first] == [
         (s.kind, s.name, s.start_line, s.end_line) for s in second
     ]
+
+
+def test_java_outline_emits_fields_nested_types_and_access_levels() -> None:
+    adapter = JavaLexicalAdapter()
+    source = _fixture_text("members.java")
+
+    symbols = adapter.outline("src/members.java", source)
+
+    prefix = "com.example.app."
+    rows = [
+        (symbol.kind, symbol.name.removeprefix(prefix), symbol.access, symbol.visibility)
+        for symbol in symbols
+    ]
+    assert rows == [
+        ("class", "Registry", "public", "public"),
+        ("field", "Registry.MAX", "public", "public"),
+        ("field", "Registry.MIN", "public", "public"),
+        ("field", "Registry.table", "protected", "private"),
+        ("field", "Registry.label", "package-private", "private"),
+        ("field", "Registry.counts", "private", "private"),
+        ("method", "Registry.toString", "public", "public"),
+        ("method", "Registry.reset", "protected", "private"),
+        ("class", "Registry.Entry", "package-private", "private"),
+        ("field", "Registry.Entry.key", "private", "private"),
+        ("constructor", "Registry.Entry.Entry", "package-private", "private"),
+        ("interface", "Registry.Entry.Visitor", "public", "public"),
+        ("field", "Registry.Entry.Visitor.LIMIT", "public", "public"),
+        ("method", "Registry.Entry.Visitor.visit", "public", "public"),
+        ("method", "Registry.rebuild", "package-private", "private"),
+    ]
+    by_name = {symbol.name: symbol for symbol in symbols}
+    assert by_name["com.example.app.Registry.table"].signature == "Map<String, List<Integer>>"
+    assert by_name["com.example.app.Registry.Entry.Visitor"].parent_symbol == (
+        "com.example.app.Registry.Entry"
+    )
+    assert all(symbol.package == "com.example.app" for symbol in symbols)
+
+
+def test_java_outline_captures_annotations_and_deprecated_marker() -> None:
+    adapter = JavaLexicalAdapter()
+    source = _fixture_text("members.java")
+
+    by_name = {symbol.name: symbol for symbol in adapter.outline("src/members.java", source)}
+
+    registry = by_name["com.example.app.Registry"]
+    assert registry.decorators == ("Component", 'SuppressWarnings("unchecked")')
+    assert by_name["com.example.app.Registry.toString"].decorators == ("Override",)
+    reset = by_name["com.example.app.Registry.reset"]
+    assert reset.decorators == ('Deprecated(since = "9")',)
+    assert (reset.deprecated, reset.deprecation_note) == (True, None)
+    assert (registry.deprecated, by_name["com.example.app.Registry.label"].decorators) == (
+        False,
+        None,
+    )
@@ -0,0 +1,136 @@
+from __future__ import annotations
+
+from pathlib import Path
+
+from repo_mcp.adapters import KotlinLexicalAdapter
+from repo_mcp.symbols import group_symbols_by_type
+
+
+def _fixture_text(name: str) -> str:
+    path = Path("tests/fixtures/adapters/kotlin") / name
+    return path.read_text(encoding="utf-8")
+
+
+def test_kotlin_outline_extracts_types_objects_functions_and_properties() -> None:
+    adapter = KotlinLexicalAdapter()
+    source = _fixture_text("sample.kt")
+
+    symbols = adapter.outline("src/sample.kt", source)
+
+    assert adapter.supports_path("src/Service.kt")
+    assert not adapter.supports_path("src/Service.java")
+
+    by_kind_name = {(symbol.kind, symbol.name): symbol for symbol in symbols}
+    assert sorted(by_kind_name) == [
+        ("class", "com.example.service.Result"),
+        ("class", "com.example.service.Result.Ok"),
+        ("class", "com.example.service.Service"),
+        ("class", "com.example.service.User"),
+        ("enum", "com.example.service.Mode"),
+        ("function", "com.example.service.parse"),
+        ("function", "com.example.service.shout"),
+        ("interface", "com.example.service.Runner"),
+        ("method", "com.example.service.Registry.register"),
+        ("method", "com.example.service.Runner.run"),
+        ("method", "com.example.service.Service.Companion.create"),
+        ("method", "com.example.service.Service.legacy"),
+        ("method", "com.example.service.Service.run"),
+        ("object", "com.example.service.Registry"),
+        ("object", "com.example.service.Result.Failed"),
+        ("object", "com.example.service.Service.Companion"),
+        ("property", "com.example.service.Registry.users"),
+        ("property", "com.example.service.Result.Ok.value"),
+        ("property", "com.example.service.Service.Companion.DEFAULT_NAME"),
+        ("property", "com.example.service.Service.calls"),
+        ("property", "com.example.service.Service.label"),
+        ("property", "com.example.service.Service.name"),
+        ("property", "com.example.service.User.id"),
+        ("property", "com.example.service.User.name"),
+        ("property", "com.example.service.second"),
+    ]
+
+    user = by_kind_name[("class", "com.example.service.User")]
+    assert (user.signature, user.start_line, user.end_line) == (
+        "(val id: Int, var name: String)",
+        14,
+        17,
+    )
+    create = by_kind_name[("method", "com.example.service.Service.Companion.create")]
+    assert create.signature == "(name: String)"
+    assert create.returns == ("Service",)
+    assert all(symbol.package == "com.example.service" for symbol in symbols)
+    assert all(symbol.qualified_name == symbol.name for symbol in symbols)
+
+
+def test_kotlin_outline_records_receivers_mutability_visibility_and_companions() -> None:
+    symbols = KotlinLexicalAdapter().outline("src/sample.kt", _fixture_text("sample.kt"))
+    by_name = {symbol.name: symbol for symbol in symbols if symbol.kind != "class"}
+
+    shout = by_name["com.example.service.shout"]
+    assert (shout.kind, shout.receiver, shout.parent_symbol) == ("function", "String", None)
+    assert by_name["com.example.service.second"].receiver == "List<T>"
+    assert by_name["com.example.service.parse"].receiver is None
+
+    assert by_name["com.example.service.User.id"].accessors == ("get",)
+    assert by_name["com.example.service.User.name"].accessors == ("get", "set")
+    calls = by_name["com.example.service.Service.calls"]
+    assert (calls.accessors, calls.end_line) == (("get", "private set"), 34)
+
+    assert [
+        (by_name[name].access, by_name[name].visibility)
+        for name in (
+            "com.example.service.Service.run",
+            "com.example.service.Registry.users",
+            "com.example.service.Service.name",
+            "com.example.service.Service.label",
+        )
+    ] == [
+        ("public", "public"),
+        ("internal", "private"),
+        ("private", "private"),
+        ("protected", "private"),
+    ]
+
+    companion = by_name["com.example.service.Service.Companion"]
+    assert (companion.kind, companion.parent_symbol, companion.scope_kind) == (
+        "object",
+        "com.example.service.Service",
+        "class",
+    )
+    assert by_name["com.example.service.Service.Companion.create"].parent_symbol == (
+        "com.example.service.Service.Companion"
+    )
+
+    legacy = by_name["com.example.service.Service.legacy"]
+    assert legacy.decorators == ('Deprecated("Use run")',)
+    assert (legacy.deprecated, legacy.deprecation_note) == (True, "Use run")
+
+    service = next(
+        node
+        for node in group_symbols_by_type(symbols)
+        if node.symbol.name == "com.example.service.Service"
+    )
+    nested = {child.symbol.name: child for child in service.children}
+    companion_node = nested["com.example.service.Service.Companion"]
+    assert [child.symbol.name for child in companion_node.children] == [
+        "com.example.service.Service.Companion.DEFAULT_NAME",
+        "com.example.service.Service.Companion.create",
+    ]
+
+
+def test_kotlin_outline_skips_local_declarations_and_reports_unbalanced_braces() -> None:
+    adapter = KotlinLexicalAdapter()
+    source = (
+        "fun outer() {\n"
+        "    val local = 1\n"
+        "    fun helper() = local\n"
+        '    val text = "class Fake { fun hidden() }"\n'
+        "}\n"
+        "class Open {\n"
+    )
+
+    names = [symbol.name for symbol in adapter.outline("src/outer.kt", source)]
+
+    assert names == ["outer", "Open"]
+    diagnostics = adapter.diagnostics("src/outer.kt", source)
+    assert [diagnostic.line for diagnostic in diagnostics or []] == [6]
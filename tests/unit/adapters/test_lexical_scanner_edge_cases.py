@@ -2,6 +2,8 @@ from __future__ import annotations
 
 from repo_mcp.adapters import (
     LexicalRules,
+    ParseDiagnostic,
+    brace_balance_diagnostics,
     extract_identifier_tokens,
     mask_comments_and_strings,
     scan_brace_blocks,
@@ -37,3 +39,13 @@ def test_custom_rules_support_sql_style_comments() -> None:
     tokens = extract_identifier_tokens(masked)
 
     assert [token.text for token in tokens] == ["SELECT", "value", "FROM", "t"]
+
+
+def test_brace_balance_diagnostics_reports_first_unmatched_and_outermost_unclosed() -> None:
+    masked = mask_comments_and_strings('}\nfunc a() {\n  s := "}"\n  if x {\n}\n')
+
+    assert brace_balance_diagnostics(masked) == [
+        ParseDiagnostic(line=1, message="unmatched '}'"),
+        ParseDiagnostic(line=2, message="'{' is never closed (1 unclosed)"),
+    ]
+    assert brace_balance_diagnostics("a {\n}\n") == []
@@ -1,7 +1,6 @@
 from __future__ import annotations
 
 import json
-from dataclasses import asdict
 from pathlib import Path
 
 from repo_mcp.adapters import (
@@ -9,10 +8,12 @@ from repo_mcp.adapters import (
     CSharpLexicalAdapter,
     GoLexicalAdapter,
     JavaLexicalAdapter,
+    KotlinLexicalAdapter,
     LexicalFallbackAdapter,
     PythonAstAdapter,
     RustLexicalAdapter,
     TypeScriptJavaScriptLexicalAdapter,
+    outline_symbol_payload,
 )
 
 
@@ -26,7 +27,7 @@ def _load_golden(name: str) -> dict[str, object]:
 
 
 def _actual_payload(adapter: object, outline_path: str, source_text: str) -> dict[str, object]:
-    symbols = [asdict(item) for item in adapter.outline(outline_path, source_text)]
+    symbols = [outline_symbol_payload(item) for item in adapter.outline(outline_path, source_text)]
     return {
         "language": adapter.name,
         "symbols": symbols,
@@ -47,6 +48,12 @@ def test_multilanguage_adapter_outputs_match_golden_snapshots() -> None:
             "src/sample.ts",
             _load_text("tests/fixtures/adapters/ts_js/sample.ts"),
         ),
+        (
+            "ts_exports",
+            TypeScriptJavaScriptLexicalAdapter(),
+            "src/exports.ts",
+            _load_text("tests/fixtures/adapters/ts_js/exports.ts"),
+        ),
         (
             "js",
             TypeScriptJavaScriptLexicalAdapter(),
@@ -83,6 +90,12 @@ def test_multilanguage_adapter_outputs_match_golden_snapshots() -> None:
             "src/sample.cs",
             _load_text("tests/fixtures/adapters/csharp/sample.cs"),
         ),
+        (
+            "kotlin",
+            KotlinLexicalAdapter(),
+            "src/sample.kt",
+            _load_text("tests/fixtures/adapters/kotlin/sample.kt"),
+        ),
         (
             "lexical",
             LexicalFallbackAdapter(),
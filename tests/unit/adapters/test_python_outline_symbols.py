@@ -1,6 +1,6 @@
 from __future__ import annotations
 
-from repo_mcp.adapters import PythonAstAdapter
+from repo_mcp.adapters import ParseDiagnostic, PythonAstAdapter
 
 
 def test_python_outline_extracts_classes_methods_functions() -> None:
@@ -97,3 +97,169 @@ def test_python_outline_handles_ast_value_error_deterministically() -> None:
     adapter = PythonAstAdapter()
     symbols = adapter.outline("nul.py", "def ok():\n    pass\0\n")
     assert symbols == []
+
+
+def test_python_outline_extracts_module_constants_and_decorators() -> None:
+    source = """
+from typing import TypeVar
+
+LIMIT = 10
+TIMEOUT: float = 2.5
+FIRST, _SECOND = 1, 2
+T = TypeVar("T")
+lowercase = 3
+ANNOTATED_ONLY: int
+
+if True:
+    FALLBACK = "x"
+
+@decorate(flag=True)
+class Holder:
+    INNER = 1
+
+    @property
+    def value(self) -> int:
+        local = 1
+        return local
+
+@functools.cache
+async def fetch() -> int:
+    return 1
+"""
+    adapter = PythonAstAdapter()
+    symbols = adapter.outline("pkg/consts.py", source)
+
+    constants = [symbol for symbol in symbols if symbol.kind == "constant"]
+    assert [symbol.name for symbol in constants] == [
+        "LIMIT",
+        "TIMEOUT",
+        "FIRST",
+        "_SECOND",
+        "FALLBACK",
+    ]
+    assert all(symbol.scope_kind == "module" for symbol in constants)
+    assert constants[-1].is_conditional is True
+    assert constants[-1].decl_context == "if"
+
+    by_name = {symbol.name: symbol for symbol in symbols}
+    assert by_name["Holder"].decorators == ("decorate(flag=True)",)
+    assert by_name["Holder.value"].decorators == ("property",)
+    assert by_name["fetch"].kind == "async_function"
+    assert by_name["fetch"].decorators == ("functools.cache",)
+    assert by_name["FIRST"].decorators is None
+
+
+def test_python_imports_report_names_aliases_and_usage() -> None:
+    source = """
+from __future__ import annotations
+
+import os.path
+import json as j
+from collections import OrderedDict, defaultdict as dd
+from . import sibling
+from .helpers import *
+
+__all__ = ["sibling"]
+
+
+def load() -> object:
+    import re
+    return j.loads(os.path.basename(str(dd)))
+"""
+    adapter = PythonAstAdapter()
+    imports = adapter.imports("pkg/loader.py", source)
+
+    assert imports is not None
+    assert [(i.line, i.path, i.name, i.alias, i.used) for i in imports] == [
+        (2, "__future__", "annotations", None, None),
+        (4, "os.path", None, None, True),
+        (5, "json", None, "j", True),
+        (6, "collections", "OrderedDict", None, False),
+        (6, "collections", "defaultdict", "dd", True),
+        (7, ".", "sibling", None, True),
+        (8, ".helpers", "*", None, None),
+        (14, "re", None, None, False),
+    ]
+    assert adapter.imports("pkg/broken.py", "def (") is None
+
+
+def test_python_outline_reports_return_annotations() -> None:
+    source = """
+def typed() -> tuple[int, str]:
+    return 1, "a"
+
+def untyped():
+    return None
+
+class Box:
+    def get(self) -> "Box":
+        return self
+"""
+    symbols = PythonAstAdapter().outline("pkg/box.py", source)
+
+    assert {symbol.name: symbol.returns for symbol in symbols} == {
+        "typed": ("tuple[int, str]",),
+        "untyped": None,
+        "Box": None,
+        "Box.get": ("'Box'",),
+    }
+
+
+def test_python_outline_qualifies_names_with_module_package() -> None:
+    adapter = PythonAstAdapter()
+    source = "class Worker:\n    def run(self) -> None:\n        pass\n"
+
+    symbols = adapter.outline("src/pkg/worker.py", source)
+    init_symbols = adapter.outline("pkg/sub/__init__.py", "def helper():\n    pass\n")
+
+    assert [(symbol.package, symbol.qualified_name) for symbol in symbols] == [
+        ("pkg.worker", "pkg.worker.Worker"),
+        ("pkg.worker", "pkg.worker.Worker.run"),
+    ]
+    assert [(symbol.package, symbol.qualified_name) for symbol in init_symbols] == [
+        ("pkg.sub", "pkg.sub.helper")
+    ]
+
+
+def test_python_outline_flags_deprecated_decorators_and_warnings() -> None:
+    source = """
+import warnings
+from typing_extensions import deprecated
+
+@deprecated("Use NewWorker instead.")
+class Worker:
+    def run(self):
+        warnings.warn("run() is going away", DeprecationWarning, stacklevel=2)
+
+@deprecated
+def legacy():
+    pass
+
+def pending():
+    warnings.warn("soon", category=PendingDeprecationWarning)
+
+def current():
+    warnings.warn("slow path", RuntimeWarning)
+"""
+    symbols = PythonAstAdapter().outline("pkg/worker.py", source)
+
+    assert [(s.name, s.deprecated, s.deprecation_note) for s in symbols] == [
+        ("Worker", True, "Use NewWorker instead."),
+        ("Worker.run", True, "run() is going away"),
+        ("legacy", True, None),
+        ("pending", True, "soon"),
+        ("current", False, None),
+    ]
+
+
+def test_python_outline_keeps_declarations_before_a_syntax_error() -> None:
+    adapter = PythonAstAdapter()
+    source = "class Worker:\n    def run(self) -> None:\n        pass\n\ndef broken(:\n    pass\n"
+
+    symbols = adapter.outline("src/worker.py", source)
+
+    assert [symbol.name for symbol in symbols] == ["Worker", "Worker.run"]
+    assert adapter.diagnostics("src/worker.py", source) == [
+        ParseDiagnostic(line=5, message="SyntaxError: invalid syntax")
+    ]
+    assert adapter.diagnostics("src/ok.py", "x = 1\n") == []
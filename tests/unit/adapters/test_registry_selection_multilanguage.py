@@ -18,6 +18,7 @@ def test_registry_selects_expected_adapter_for_known_extensions(tmp_path: Path)
     assert registry.select("src/mod.rs").name == "rust_lexical"
     assert registry.select("src/mod.cpp").name == "cpp_lexical"
     assert registry.select("src/Mod.cs").name == "csharp_lexical"
+    assert registry.select("src/Mod.kt").name == "kotlin_lexical"
     assert registry.select("docs/notes.md").name == "lexical"
 
 
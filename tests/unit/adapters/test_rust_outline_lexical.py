@@ -58,3 +58,56 @@ def test_rust_outline_is_deterministic_for_repeated_calls() -> None:
     assert [(s.kind, s.name, s.start_line, s.end_line) for s in first] == [
         (s.kind, s.name, s.start_line, s.end_line) for s in second
     ]
+
+
+def test_rust_outline_extracts_members_visibility_and_trait_impls() -> None:
+    adapter = RustLexicalAdapter()
+    source = _fixture_text("traits.rs")
+
+    symbols = adapter.outline("src/traits.rs", source)
+    by_kind_name = {(symbol.kind, symbol.name): symbol for symbol in symbols}
+
+    assert [s.name for s in symbols if s.kind == "variant"] == [
+        "State.Idle",
+        "State.Busy",
+        "State.Failed",
+    ]
+    assert all(s.parent_symbol == "State" for s in symbols if s.kind == "variant")
+    assert by_kind_name[("method", "Worker.start")].signature == "(&mut self)"
+    assert by_kind_name[("method", "Worker.name")].parent_symbol == "Worker"
+    assert ("static", "COUNTER") in by_kind_name
+    assert ("function", "capacity") in by_kind_name
+    assert by_kind_name[("method", "State.fmt")].end_line == 48
+
+    assert by_kind_name[("struct", "Queue")].visibility == "private"
+    assert by_kind_name[("static", "LIMIT")].visibility == "public"
+    assert by_kind_name[("static", "COUNTER")].visibility == "private"
+    assert by_kind_name[("method", "Queue.push")].visibility == "private"
+    assert by_kind_name[("method", "Queue.start")].visibility == "public"
+    assert by_kind_name[("variant", "State.Busy")].visibility == "public"
+
+    assert by_kind_name[("struct", "Queue")].implements == ("From", "Worker")
+    assert by_kind_name[("enum", "State")].implements == ("fmt::Display",)
+    assert by_kind_name[("trait", "Worker")].implements is None
+    assert [s.implements for s in symbols if s.kind == "impl"] == [
+        None,
+        ("Worker",),
+        ("fmt::Display",),
+        ("From",),
+    ]
+
+
+def test_rust_outline_package_follows_module_path_under_src() -> None:
+    adapter = RustLexicalAdapter()
+    source = "pub struct Engine;\n"
+
+    packages = {
+        path: [(symbol.package, symbol.qualified_name) for symbol in adapter.outline(path, source)]
+        for path in ("src/lib.rs", "src/engine/mod.rs", "crates/core/src/engine/pool.rs")
+    }
+
+    assert packages == {
+        "src/lib.rs": [("crate", "crate.Engine")],
+        "src/engine/mod.rs": [("crate.engine", "crate.engine.Engine")],
+        "crates/core/src/engine/pool.rs": [("crate.engine.pool", "crate.engine.pool.Engine")],
+    }
@@ -44,3 +44,106 @@ def test_typescript_outline_extracts_top_level_and_methods() -> None:
 
     assert names == [symbol.name for symbol in adapter.outline("src/sample.ts", source)]
     assert kinds == [symbol.kind for symbol in adapter.outline("src/sample.ts", source)]
+
+
+def test_typescript_outline_reports_export_visibility_and_class_properties() -> None:
+    adapter = TypeScriptJavaScriptLexicalAdapter()
+    symbols = adapter.outline("src/exports.ts", _fixture_text("exports.ts"))
+    by_name = {symbol.name: symbol for symbol in symbols}
+
+    visibility = {name: symbol.visibility for name, symbol in by_name.items()}
+    assert visibility["Options"] == "public"
+    assert visibility["Internal"] == "private"
+    assert visibility["Registry"] == "public"
+    assert visibility["normalize"] == "public"
+    assert visibility["load"] == "public"
+    assert visibility["Registry.resolve"] == "private"
+    assert visibility["Registry.#secret"] == "private"
+    assert visibility["Registry.retries"] == "private"
+    assert visibility["Registry.register"] == "public"
+
+    assert by_name["DEFAULT_RETRIES"].kind == "exported_variable"
+    assert (by_name["cache"].kind, by_name["counter"].kind) == ("variable", "variable")
+    properties = [
+        (symbol.name, symbol.signature) for symbol in symbols if symbol.kind == "property"
+    ]
+    assert properties == [
+        ("Registry.instances", None),
+        ("Registry.name", "string"),
+        ("Registry.store", "Map<string, Handler>"),
+        ("Registry.retries", "number"),
+        ("Registry.#secret", None),
+        ("Registry.onChange", None),
+    ]
+
+
+def test_typescript_outline_understands_export_default_forms() -> None:
+    adapter = TypeScriptJavaScriptLexicalAdapter()
+
+    anonymous = adapter.outline("src/a.ts", "export default function (value: string) {\n}\n")
+    assert [(s.kind, s.name, s.signature, s.visibility) for s in anonymous] == [
+        ("function", "default", "(value: string)", "public")
+    ]
+
+    named = adapter.outline("src/b.ts", "class Store {\n  size = 0;\n}\n\nexport default Store;\n")
+    assert [(s.kind, s.name, s.visibility) for s in named] == [
+        ("class", "Store", "public"),
+        ("property", "Store.size", "public"),
+    ]
+
+    expression = adapter.outline("src/c.ts", "export default { debug: false };\n")
+    assert [(s.kind, s.name) for s in expression] == [("exported_variable", "default")]
+
+    subclass = adapter.outline("src/d.ts", "export default class extends Base {\n  run() {}\n}\n")
+    assert [(s.kind, s.name) for s in subclass] == [("class", "default"), ("method", "default.run")]
+
+
+def test_typescript_imports_report_bindings_and_usage() -> None:
+    adapter = TypeScriptJavaScriptLexicalAdapter()
+    imports = adapter.imports("src/imports.ts", _fixture_text("imports.ts"))
+
+    assert imports is not None
+    assert [(i.line, i.path, i.name, i.alias, i.used) for i in imports] == [
+        (1, "events", "EventEmitter", None, True),
+        (1, "events", "Listener", "L", False),
+        (2, "path", "*", "path", True),
+        (3, "fs", "default", "fs", True),
+        (3, "fs", "readFile", None, True),
+        (4, "./polyfill", None, None, None),
+        (6, "./types", "Options", None, True),
+        (6, "./types", "Unused", None, False),
+        (10, "lodash", None, "lodash", False),
+        (11, "path", "dirname", None, False),
+        (11, "path", "join", "pjoin", True),
+    ]
+
+
+def test_typescript_outline_flags_jsdoc_deprecated_tags() -> None:
+    adapter = TypeScriptJavaScriptLexicalAdapter()
+    source = (
+        "/**\n"
+        " * Builds a runner.\n"
+        " * @deprecated Use createRunner,\n"
+        " *   which validates options.\n"
+        " * @param name runner name\n"
+        " */\n"
+        "export function build(name: string) {}\n"
+        "\n"
+        "/** @deprecated */\n"
+        "export class Legacy {\n"
+        "  /** @deprecated use next() */\n"
+        "  run() {}\n"
+        "}\n"
+        "\n"
+        "/** Fresh API. */\n"
+        "export function fresh() {}\n"
+    )
+
+    symbols = adapter.outline("src/runner.ts", source)
+
+    assert [(s.name, s.deprecated, s.deprecation_note) for s in symbols] == [
+        ("build", True, "Use createRunner, which validates options."),
+        ("Legacy", True, None),
+        ("Legacy.run", True, "use next()"),
+        ("fresh", False, None),
+    ]
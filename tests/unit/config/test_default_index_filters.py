@@ -37,6 +37,7 @@ def test_default_config_includes_v25_multilanguage_source_extensions(tmp_path: P
         ".rs",
         ".cpp",
         ".cs",
+        ".kt",
         ".md",
         ".toml",
     }
@@ -34,3 +34,67 @@ def test_invalid_section_type_raises_value_error(tmp_path: Path) -> None:
 
     with pytest.raises(ValueError, match="section 'limits'"):
         create_server(repo_root=str(tmp_path))
+
+
+def test_scan_concurrency_above_cap_raises_value_error(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text("[scan]\nconcurrency = 65\n", encoding="utf-8")
+
+    with pytest.raises(ValueError, match="scan.concurrency"):
+        create_server(repo_root=str(tmp_path))
+
+
+def test_unknown_config_keys_raise_value_error(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text("[scan]\nskip_test = true\n", encoding="utf-8")
+    with pytest.raises(ValueError, match="Unknown config key 'scan.skip_test'"):
+        create_server(repo_root=str(tmp_path))
+
+    (tmp_path / "repo_mcp.toml").write_text("[exports]\nformat = 'json'\n", encoding="utf-8")
+    with pytest.raises(ValueError, match="Unknown config section 'exports'"):
+        create_server(repo_root=str(tmp_path))
+
+
+def test_invalid_output_format_raises_value_error(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text('[output]\nformat = "xml"\n', encoding="utf-8")
+
+    with pytest.raises(ValueError, match="output.format"):
+        create_server(repo_root=str(tmp_path))
+
+
+def test_missing_explicit_config_path_raises_value_error(tmp_path: Path) -> None:
+    with pytest.raises(ValueError, match="Config file not found"):
+        create_server(repo_root=str(tmp_path), config_path=str(tmp_path / "missing.toml"))
+
+
+def test_unknown_go_target_raises_value_error(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text('[scan]\ngoos = "beos"\n', encoding="utf-8")
+
+    with pytest.raises(ValueError, match="scan.goos"):
+        create_server(repo_root=str(tmp_path))
+
+
+def test_unknown_symbol_kind_raises_value_error(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text('[scan]\nkinds = ["type", "klass"]\n', encoding="utf-8")
+
+    with pytest.raises(ValueError, match="scan.kinds' has unknown kind 'klass'"):
+        create_server(repo_root=str(tmp_path))
+
+
+def test_scan_max_file_size_above_cap_raises_value_error(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text("[scan]\nmax_file_size = 8388608\n", encoding="utf-8")
+
+    with pytest.raises(ValueError, match="scan.max_file_size' must be <= 4194304"):
+        create_server(repo_root=str(tmp_path))
+
+
+def test_invalid_redact_patterns_raise_value_error(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text(
+        '[security]\nredact_patterns = ["ok-[0-9]+", "bad("]\n', encoding="utf-8"
+    )
+    with pytest.raises(ValueError, match="security.redact_patterns' entry 2"):
+        create_server(repo_root=str(tmp_path))
+
+    (tmp_path / "repo_mcp.toml").write_text(
+        '[security]\nredact_patterns = ["x*"]\n', encoding="utf-8"
+    )
+    with pytest.raises(ValueError, match="must not match the empty string"):
+        create_server(repo_root=str(tmp_path))
@@ -43,3 +43,166 @@ def test_data_dir_override_has_highest_precedence(tmp_path: Path) -> None:
     response = call_tool(server, "req-data-dir", "repo.status", {})
     effective = extract_result(response)["effective_config"]
     assert effective["data_dir"] == str(custom_data_dir.resolve())
+
+
+def test_scan_concurrency_merges_repo_config_then_cli(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text("[scan]\nconcurrency = 3\n", encoding="utf-8")
+
+    from_repo = create_server(repo_root=str(tmp_path))
+    effective = extract_result(call_tool(from_repo, "req-scan-1", "repo.status", {}))
+    assert effective["effective_config"]["scan"] == {
+        "concurrency": 3,
+        "skip_tests": False,
+        "watch_debounce_ms": 300,
+        "goos": None,
+        "goarch": None,
+        "strict": False,
+        "kinds": None,
+        "max_file_size": None,
+    }
+
+    from_cli = create_server(repo_root=str(tmp_path), cli_overrides=CliOverrides(concurrency=5))
+    effective = extract_result(call_tool(from_cli, "req-scan-2", "repo.status", {}))
+    assert effective["effective_config"]["scan"] == {
+        "concurrency": 5,
+        "skip_tests": False,
+        "watch_debounce_ms": 300,
+        "goos": None,
+        "goarch": None,
+        "strict": False,
+        "kinds": None,
+        "max_file_size": None,
+    }
+
+
+def test_scan_skip_tests_merges_repo_config_then_cli(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text("[scan]\nskip_tests = true\n", encoding="utf-8")
+
+    from_repo = create_server(repo_root=str(tmp_path))
+    effective = extract_result(call_tool(from_repo, "req-skip-1", "repo.status", {}))
+    assert effective["effective_config"]["scan"]["skip_tests"] is True
+
+    (tmp_path / "repo_mcp.toml").write_text("[scan]\nconcurrency = 2\n", encoding="utf-8")
+    from_cli = create_server(repo_root=str(tmp_path), cli_overrides=CliOverrides(skip_tests=True))
+    effective = extract_result(call_tool(from_cli, "req-skip-2", "repo.status", {}))
+    assert effective["effective_config"]["scan"] == {
+        "concurrency": 2,
+        "skip_tests": True,
+        "watch_debounce_ms": 300,
+        "goos": None,
+        "goarch": None,
+        "strict": False,
+        "kinds": None,
+        "max_file_size": None,
+    }
+
+
+def test_scan_kinds_merges_repo_config_then_cli(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text(
+        '[scan]\nkinds = ["method", "type", "method"]\n', encoding="utf-8"
+    )
+
+    from_repo = create_server(repo_root=str(tmp_path))
+    effective = extract_result(call_tool(from_repo, "req-kinds-1", "repo.status", {}))
+    assert effective["effective_config"]["scan"]["kinds"] == ["method", "type"]
+
+    from_cli = create_server(
+        repo_root=str(tmp_path), cli_overrides=CliOverrides(kinds=("interface",))
+    )
+    effective = extract_result(call_tool(from_cli, "req-kinds-2", "repo.status", {}))
+    assert effective["effective_config"]["scan"]["kinds"] == ["interface"]
+
+
+def test_index_globs_merge_repo_config_then_cli(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text(
+        "\n".join(
+            [
+                "[index]",
+                'exclude_globs = ["**/vendor/**"]',
+                'include_globs = ["src/**"]',
+                "respect_gitignore = false",
+            ]
+        ),
+        encoding="utf-8",
+    )
+    overrides = CliOverrides(
+        exclude_globs=("**/testdata/**",),
+        include_globs=("pkg/**",),
+        respect_gitignore=True,
+    )
+    server = create_server(repo_root=str(tmp_path), cli_overrides=overrides)
+
+    effective = extract_result(call_tool(server, "req-globs", "repo.status", {}))
+    index = effective["effective_config"]["index"]
+    assert index["exclude_globs"] == ["**/vendor/**", "**/testdata/**"]
+    assert index["include_globs"] == ["pkg/**"]
+    assert index["respect_gitignore"] is True
+
+
+def test_output_defaults_merge_config_file_then_cli(tmp_path: Path) -> None:
+    custom = tmp_path / "team.toml"
+    custom.write_text(
+        '[output]\nformat = "markdown"\npublic_only = true\ngraph_level = "file"\n'
+        'progress = "never"\ngroup_by_type = true\nmin_complexity = 5\n',
+        encoding="utf-8",
+    )
+    (tmp_path / "repo_mcp.toml").write_text('[output]\nformat = "jsonl"\n', encoding="utf-8")
+
+    from_repo = create_server(repo_root=str(tmp_path))
+    effective = extract_result(call_tool(from_repo, "req-out-1", "repo.status", {}))
+    assert effective["effective_config"]["output"] == {
+        "format": "jsonl",
+        "public_only": False,
+        "deprecated_only": False,
+        "graph_level": "package",
+        "progress": "auto",
+        "group_by_type": False,
+        "min_complexity": None,
+        "db_path": None,
+    }
+
+    from_path = create_server(repo_root=str(tmp_path), config_path=str(custom))
+    effective = extract_result(call_tool(from_path, "req-out-2", "repo.status", {}))
+    assert effective["effective_config"]["output"] == {
+        "format": "markdown",
+        "public_only": True,
+        "deprecated_only": False,
+        "graph_level": "file",
+        "progress": "never",
+        "group_by_type": True,
+        "min_complexity": 5,
+        "db_path": None,
+    }
+
+    from_cli = create_server(
+        repo_root=str(tmp_path),
+        config_path=str(custom),
+        cli_overrides=CliOverrides(
+            output_format="json", graph_level="symbol", progress="always", min_complexity=8
+        ),
+    )
+    effective = extract_result(call_tool(from_cli, "req-out-3", "repo.status", {}))
+    assert effective["effective_config"]["output"] == {
+        "format": "json",
+        "public_only": True,
+        "deprecated_only": False,
+        "graph_level": "symbol",
+        "progress": "always",
+        "group_by_type": True,
+        "min_complexity": 8,
+        "db_path": None,
+    }
+
+
+def test_watch_debounce_merges_repo_config_then_cli(tmp_path: Path) -> None:
+    (tmp_path / "repo_mcp.toml").write_text("[scan]\nwatch_debounce_ms = 750\n", encoding="utf-8")
+
+    from_repo = create_server(repo_root=str(tmp_path))
+    effective = extract_result(call_tool(from_repo, "req-watch-1", "repo.status", {}))
+    assert effective["effective_config"]["scan"]["watch_debounce_ms"] == 750
+
+    from_cli = create_server(
+        repo_root=str(tmp_path), cli_overrides=CliOverrides(watch_debounce_ms=50)
+    )
+    effective = extract_result(call_tool(from_cli, "req-watch-2", "repo.status", {}))
+    assert effective["effective_config"]["scan"]["watch_debounce_ms"] == 50
@@ -0,0 +1,88 @@
+from __future__ import annotations
+
+from pathlib import Path
+
+from repo_mcp.config import IndexConfig
+from repo_mcp.index import discover_files
+from repo_mcp.index.gitignore import is_ignored, parse_gitignore
+
+
+def test_gitignore_patterns_follow_git_matching_rules() -> None:
+    rules = parse_gitignore(
+        "\n".join(
+            [
+                "# build output",
+                "*.log",
+                "!keep.log",
+                "/dist",
+                "build/",
+                "docs/**/draft-*.md",
+                "\\#literal",
+            ]
+        )
+    )
+
+    assert is_ignored(rules, "app.log", is_dir=False)
+    assert is_ignored(rules, "nested/deep/app.log", is_dir=False)
+    assert not is_ignored(rules, "nested/keep.log", is_dir=False)
+    assert is_ignored(rules, "dist", is_dir=True)
+    assert not is_ignored(rules, "pkg/dist", is_dir=True)
+    assert is_ignored(rules, "pkg/build", is_dir=True)
+    assert not is_ignored(rules, "pkg/build", is_dir=False)
+    assert is_ignored(rules, "docs/draft-a.md", is_dir=False)
+    assert is_ignored(rules, "docs/guides/v1/draft-b.md", is_dir=False)
+    assert not is_ignored(rules, "docs/final.md", is_dir=False)
+    assert is_ignored(rules, "#literal", is_dir=False)
+
+
+def test_nested_gitignore_rules_apply_below_their_directory() -> None:
+    rules = parse_gitignore("*.gen.go\n") + parse_gitignore("!keep.gen.go\n/local\n", base="pkg")
+
+    assert is_ignored(rules, "a.gen.go", is_dir=False)
+    assert not is_ignored(rules, "pkg/keep.gen.go", is_dir=False)
+    assert is_ignored(rules, "keep.gen.go", is_dir=False)
+    assert is_ignored(rules, "pkg/local", is_dir=True)
+    assert not is_ignored(rules, "local", is_dir=True)
+
+
+def test_discovery_respects_root_and_nested_gitignore(tmp_path: Path) -> None:
+    (tmp_path / "vendor" / "lib").mkdir(parents=True)
+    (tmp_path / "pkg" / "local").mkdir(parents=True)
+    (tmp_path / ".gitignore").write_text("vendor/\n*.gen.go\n", encoding="utf-8")
+    (tmp_path / "pkg" / ".gitignore").write_text("local/\n!keep.gen.go\n", encoding="utf-8")
+    (tmp_path / "main.go").write_text("package main\n", encoding="utf-8")
+    (tmp_path / "types.gen.go").write_text("package main\n", encoding="utf-8")
+    (tmp_path / "vendor" / "lib" / "lib.go").write_text("package lib\n", encoding="utf-8")
+    (tmp_path / "pkg" / "pkg.go").write_text("package pkg\n", encoding="utf-8")
+    (tmp_path / "pkg" / "keep.gen.go").write_text("package pkg\n", encoding="utf-8")
+    (tmp_path / "pkg" / "local" / "scratch.go").write_text("package local\n", encoding="utf-8")
+
+    config = IndexConfig(include_extensions=(".go",), exclude_globs=())
+    profile: dict[str, object] = {}
+    records = discover_files(tmp_path, config=config, profile=profile)
+
+    assert [record.path for record in records] == ["main.go", "pkg/keep.gen.go", "pkg/pkg.go"]
+    assert profile["excluded_by_gitignore"] == 1
+
+    disabled = IndexConfig(include_extensions=(".go",), exclude_globs=(), respect_gitignore=False)
+    assert len(discover_files(tmp_path, config=disabled)) == 6
+
+
+def test_discovery_include_globs_restrict_scan(tmp_path: Path) -> None:
+    (tmp_path / "src" / "testdata").mkdir(parents=True)
+    (tmp_path / "tools").mkdir()
+    (tmp_path / "src" / "app.py").write_text("x = 1\n", encoding="utf-8")
+    (tmp_path / "src" / "testdata" / "case.py").write_text("y = 2\n", encoding="utf-8")
+    (tmp_path / "tools" / "gen.py").write_text("z = 3\n", encoding="utf-8")
+
+    config = IndexConfig(
+        include_extensions=(".py",),
+        exclude_globs=("**/testdata/**",),
+        include_globs=("src/**",),
+    )
+    profile: dict[str, object] = {}
+    records = discover_files(tmp_path, config=config, profile=profile)
+
+    assert [record.path for record in records] == ["src/app.py"]
+    assert profile["excluded_by_include"] == 1
+    assert profile["excluded_by_glob"] == 1
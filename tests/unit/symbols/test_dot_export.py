@@ -0,0 +1,111 @@
+from __future__ import annotations
+
+import pytest
+
+from repo_mcp.adapters import FileImport, OutlineSymbol
+from repo_mcp.symbols import FileSymbols, render_dot
+
+
+def _function(
+    name: str,
+    package: str,
+    start_line: int,
+    calls: tuple[str, ...] | None = None,
+) -> OutlineSymbol:
+    return OutlineSymbol(
+        kind="function",
+        name=name,
+        signature="()",
+        start_line=start_line,
+        end_line=start_line,
+        doc=None,
+        calls=calls,
+        package=package,
+        qualified_name=name,
+    )
+
+
+def _import(path: str) -> FileImport:
+    return FileImport(path=path, name=None, alias=None, line=3, used=True)
+
+
+def _groups() -> list[FileSymbols]:
+    return [
+        FileSymbols(
+            path="cmd/app/main.go",
+            language="go_lexical",
+            symbols=(_function("main.main", "main", 5, calls=("worker.Build", "fmt.Println")),),
+            imports=(_import("fmt"), _import("example.com/app/pkg/worker")),
+        ),
+        FileSymbols(
+            path="pkg/worker/worker.go",
+            language="go_lexical",
+            symbols=(
+                _function("worker.Build", "worker", 3, calls=("worker.helper",)),
+                _function("worker.helper", "worker", 9),
+            ),
+            imports=(),
+        ),
+        FileSymbols(path="docs/empty.md", language="lexical", symbols=()),
+    ]
+
+
+def test_render_dot_package_level_links_imports_and_calls_between_packages() -> None:
+    assert render_dot(_groups(), "package") == (
+        'digraph "symbols" {\n'
+        '  graph [rankdir="LR"];\n'
+        '  node [shape="box"];\n'
+        '  "main" [label="main", tooltip="main"];\n'
+        '  "worker" [label="worker", tooltip="worker"];\n'
+        '  "main" -> "worker" [style="dashed", tooltip="calls"];\n'
+        '  "main" -> "worker" [style="solid", tooltip="imports"];\n'
+        "}\n"
+    )
+
+
+def test_render_dot_file_and_symbol_levels() -> None:
+    file_graph = render_dot(_groups(), "file").splitlines()
+    assert file_graph[3:-1] == [
+        '  "cmd/app/main.go" [label="main.go", tooltip="cmd/app/main.go"];',
+        '  "pkg/worker/worker.go" [label="worker.go", tooltip="pkg/worker/worker.go"];',
+        '  "cmd/app/main.go" -> "pkg/worker/worker.go" [style="dashed", tooltip="calls"];',
+        '  "cmd/app/main.go" -> "pkg/worker/worker.go" [style="solid", tooltip="imports"];',
+    ]
+
+    symbol_graph = render_dot(_groups(), "symbol").splitlines()
+    assert symbol_graph[3:-1] == [
+        '  "main.main" [label="main", tooltip="main.main"];',
+        '  "worker.Build" [label="Build", tooltip="worker.Build"];',
+        '  "worker.helper" [label="helper", tooltip="worker.helper"];',
+        '  "main.main" -> "worker.Build" [style="dashed", tooltip="calls"];',
+        '  "worker.Build" -> "worker.helper" [style="dashed", tooltip="calls"];',
+    ]
+
+
+def test_render_dot_resolves_relative_python_and_typescript_imports() -> None:
+    def module(path: str, package: str, imports: tuple[FileImport, ...]) -> FileSymbols:
+        symbol = _function(f"{package}.run", package, 1)
+        return FileSymbols(path=path, language="python", symbols=(symbol,), imports=imports)
+
+    groups = [
+        module(
+            "src/app/service.py",
+            "app.service",
+            (FileImport(path=".models", name="User", alias=None, line=1, used=True),),
+        ),
+        module("src/app/models.py", "app.models", ()),
+        module("web/main.ts", "web/main", (_import("./lib/index"),)),
+        module("web/lib/index.ts", "web/lib", ()),
+    ]
+
+    edges = [line for line in render_dot(groups, "package").splitlines() if "->" in line]
+
+    assert edges == [
+        '  "app.service" -> "app.models" [style="solid", tooltip="imports"];',
+        '  "web/main" -> "web/lib" [style="solid", tooltip="imports"];',
+    ]
+
+
+def test_render_dot_rejects_unknown_graph_level() -> None:
+    with pytest.raises(ValueError, match="graph level"):
+        render_dot(_groups(), "module")
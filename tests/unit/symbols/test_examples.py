@@ -0,0 +1,66 @@
+from __future__ import annotations
+
+from pathlib import Path
+
+from repo_mcp.adapters.go import GoLexicalAdapter
+from repo_mcp.symbols import FileSymbols, attach_examples, example_target
+
+_FIXTURES = Path(__file__).resolve().parents[2] / "fixtures" / "adapters" / "go"
+
+
+def _group(path: str, fixture: str) -> FileSymbols:
+    text = (_FIXTURES / fixture).read_text(encoding="utf-8")
+    return FileSymbols(
+        path=path, language="go", symbols=tuple(GoLexicalAdapter().outline(path, text))
+    )
+
+
+def test_example_target_follows_go_doc_naming() -> None:
+    assert example_target("worker.ExampleBuild") == "Build"
+    assert example_target("worker.ExampleService_Run") == "Service.Run"
+    assert example_target("worker.ExampleService_Run_withTimeout") == "Service.Run"
+    assert example_target("worker.ExampleBuild_second") == "Build"
+    assert example_target("worker.Example") is None
+    assert example_target("worker.Example_suffix") is None
+    assert example_target("worker.ExampleA_B_C") is None
+
+
+def test_attach_examples_links_test_file_examples_to_package_symbols() -> None:
+    groups = [
+        _group("worker/worker.go", "sample.go"),
+        _group("worker/worker_test.go", "worker_test.go"),
+        _group("other/worker.go", "sample.go"),
+    ]
+
+    linked = attach_examples(groups)
+
+    examples = {
+        (group.path, symbol.name): symbol.examples
+        for group in linked
+        for symbol in group.symbols
+        if symbol.examples is not None and symbol.role != "example"
+    }
+    assert examples == {
+        ("worker/worker.go", "worker.Build"): (
+            'svc := Build("indexer")\nfmt.Println(svc.name)\n// Output: indexer',
+        ),
+        ("worker/worker.go", "worker.Service.Run"): (
+            '_ = Build("indexer").Run(context.Background())',
+        ),
+    }
+    assert [group.path for group in linked] == [group.path for group in groups]
+
+
+def test_attach_examples_accepts_external_test_package() -> None:
+    source = (_FIXTURES / "worker_test.go").read_text(encoding="utf-8")
+    external = source.replace("package worker\n", "package worker_test\n", 1)
+    tests = FileSymbols(
+        path="worker/example_test.go",
+        language="go",
+        symbols=tuple(GoLexicalAdapter().outline("worker/example_test.go", external)),
+    )
+
+    linked = attach_examples([_group("worker/worker.go", "sample.go"), tests])
+
+    build = next(s for s in linked[0].symbols if s.name == "worker.Build")
+    assert build.examples is not None and len(build.examples) == 1
@@ -0,0 +1,148 @@
+from __future__ import annotations
+
+import json
+from dataclasses import fields
+from pathlib import Path
+
+import pytest
+
+from repo_mcp.adapters import GoLexicalAdapter, OutlineSymbol
+from repo_mcp.symbols import (
+    EXPORT_VERSION,
+    JSON_SCHEMA_DIALECT,
+    Diagnostic,
+    FileSymbols,
+    export_schema,
+    render_export_schema,
+    write_symbol_export,
+)
+
+_SOURCE = (
+    "package worker\n\n"
+    'import "fmt"\n\n'
+    "// Service runs jobs.\n"
+    "type Service struct {\n"
+    '\tName string `json:"name"`\n'
+    "}\n\n"
+    "// Run prints the name.\n"
+    "func (s *Service) Run() { fmt.Println(s.Name) }\n"
+)
+_JSON_TYPES: dict[str, type] = {
+    "array": list,
+    "boolean": bool,
+    "integer": int,
+    "null": type(None),
+    "object": dict,
+    "string": str,
+}
+
+
+def _matches_type(value: object, name: str) -> bool:
+    if name in {"integer", "boolean"} or isinstance(value, bool):
+        return type(value) is _JSON_TYPES[name]
+    return isinstance(value, _JSON_TYPES[name])
+
+
+def _validate(value: object, schema: dict, root: dict, where: str = "$") -> None:
+    """Check value against the keywords export_schema uses."""
+    if "$ref" in schema:
+        _validate(value, root["$defs"][schema["$ref"].removeprefix("#/$defs/")], root, where)
+        return
+    if "anyOf" in schema:
+        errors = []
+        for option in schema["anyOf"]:
+            try:
+                _validate(value, option, root, where)
+                return
+            except AssertionError as error:
+                errors.append(error)
+        raise AssertionError(f"{where}: no anyOf option matched: {errors}")
+    if "const" in schema:
+        assert value == schema["const"], where
+    kinds = schema.get("type")
+    if kinds is not None:
+        names = [kinds] if isinstance(kinds, str) else kinds
+        assert any(_matches_type(value, name) for name in names), f"{where}: {value!r}"
+    if isinstance(value, dict):
+        properties = schema.get("properties", {})
+        for key in schema.get("required", []):
+            assert key in value, f"{where}: missing {key}"
+        extra = schema.get("additionalProperties", True)
+        for key, item in value.items():
+            if key in properties:
+                _validate(item, properties[key], root, f"{where}.{key}")
+            elif extra is False:
+                raise AssertionError(f"{where}: unexpected key {key}")
+            elif isinstance(extra, dict):
+                _validate(item, extra, root, f"{where}.{key}")
+    if isinstance(value, list) and "items" in schema:
+        for index, item in enumerate(value):
+            _validate(item, schema["items"], root, f"{where}[{index}]")
+
+
+def test_export_schema_describes_every_symbol_field_and_marks_children_optional() -> None:
+    schema = export_schema()
+
+    assert schema["$schema"] == JSON_SCHEMA_DIALECT
+    assert schema["properties"]["export_version"] == {"const": EXPORT_VERSION}
+    symbol = schema["$defs"]["symbol"]
+    field_names = sorted(field.name for field in fields(OutlineSymbol))
+    assert symbol["required"] == field_names
+    assert sorted(symbol["properties"]) == sorted([*field_names, "children"])
+    assert symbol["properties"]["doc"] == {"type": ["string", "null"]}
+    assert symbol["properties"]["start_line"] == {"type": "integer"}
+    assert symbol["properties"]["start_col"] == {"type": ["integer", "null"]}
+    assert symbol["properties"]["id"] == {"type": "string"}
+    assert symbol["properties"]["tags"] == {
+        "type": ["object", "null"],
+        "additionalProperties": {"type": "string"},
+    }
+    assert symbol["properties"]["decorators"] == {
+        "type": ["array", "null"],
+        "items": {"type": "string"},
+    }
+    assert schema["$defs"]["file"]["required"] == ["imports", "language", "path", "symbols"]
+    assert render_export_schema() == json.dumps(schema, sort_keys=True, indent=2) + "\n"
+
+
+def test_json_exports_conform_to_export_schema(tmp_path: Path) -> None:
+    symbols = tuple(GoLexicalAdapter().outline("worker/service.go", _SOURCE))
+    group = FileSymbols(
+        path="worker/service.go",
+        language="go",
+        symbols=symbols,
+        imports=tuple(GoLexicalAdapter().imports("worker/service.go", _SOURCE) or ()),
+    )
+    schema = export_schema()
+
+    for group_by_type in (False, True):
+        destination = tmp_path / f"symbols-{group_by_type}.json"
+        write_symbol_export(
+            [group],
+            destination,
+            "json",
+            diagnostics=[Diagnostic(path="worker/broken.go", line=None, message="failed")],
+            group_by_type=group_by_type,
+        )
+        document = json.loads(destination.read_text(encoding="utf-8"))
+
+        _validate(document, schema, schema)
+        assert document["files"][0]["imports"]
+        service = document["files"][0]["symbols"][0]
+        assert service["name"] == "worker.Service"
+        assert ("children" in service) is group_by_type
+
+
+def test_schema_rejects_null_required_fields_and_unknown_keys() -> None:
+    schema = export_schema()
+    symbol = schema["$defs"]["symbol"]
+    payload = {name: None for name in symbol["required"]}
+
+    with pytest.raises(AssertionError, match=r"\$\.end_line: None"):
+        _validate(payload, symbol, schema)
+    with pytest.raises(AssertionError, match="unexpected key extra"):
+        _validate(
+            {"path": "a.go", "line": 1, "message": "m", "extra": 1},
+            schema["$defs"]["diagnostic"],
+            schema,
+        )
@@ -0,0 +1,89 @@
+from __future__ import annotations
+
+from repo_mcp.adapters import GoLexicalAdapter, OutlineSymbol
+from repo_mcp.symbols import (
+    FileSymbols,
+    file_symbols_payload,
+    group_symbols_by_type,
+    render_markdown_overview,
+)
+
+_SOURCE = (
+    "package worker\n\n"
+    "// Service runs jobs.\n"
+    "type Service struct {\n\tname string\n}\n\n"
+    "func (s *Service) Run() {}\n\n"
+    "func (s Service) Name() string { return s.name }\n\n"
+    "func (c Client) Dial() {}\n\n"
+    "func Build() *Service { return nil }\n"
+)
+
+
+def _symbol(kind: str, name: str, parent: str | None, line: int) -> OutlineSymbol:
+    return OutlineSymbol(
+        kind=kind,
+        name=name,
+        signature=None,
+        start_line=line,
+        end_line=line,
+        doc=None,
+        parent_symbol=parent,
+    )
+
+
+def test_group_symbols_by_type_nests_members_recursively_in_outline_order() -> None:
+    symbols = (
+        _symbol("class", "Outer", None, 1),
+        _symbol("method", "Outer.run", "Outer", 2),
+        _symbol("class", "Outer.Inner", "Outer", 3),
+        _symbol("method", "Outer.Inner.step", "Outer.Inner", 4),
+        _symbol("method", "Remote.call", "Remote", 5),
+        _symbol("function", "main", None, 6),
+    )
+
+    nodes = group_symbols_by_type(symbols)
+
+    assert [node.symbol.name for node in nodes] == ["Outer", "Remote.call", "main"]
+    outer = nodes[0]
+    assert [child.symbol.name for child in outer.children] == ["Outer.run", "Outer.Inner"]
+    assert [child.symbol.name for child in outer.children[1].children] == ["Outer.Inner.step"]
+    assert nodes[1].children == ()
+
+
+def test_grouped_payload_nests_go_methods_and_keeps_free_functions_top_level() -> None:
+    symbols = tuple(GoLexicalAdapter().outline("worker/service.go", _SOURCE))
+    group = FileSymbols(path="worker/service.go", language="go_lexical", symbols=symbols)
+
+    payload = file_symbols_payload(group, group_by_type=True)
+
+    top_level = [(item["name"], item["receiver"]) for item in payload["symbols"]]
+    assert top_level == [
+        ("worker.Service", None),
+        ("worker.Client.Dial", "Client"),
+        ("worker.Build", None),
+    ]
+    service = payload["symbols"][0]
+    assert [(item["name"], item["receiver"]) for item in service["children"]] == [
+        ("worker.Service.name", None),
+        ("worker.Service.Run", "*Service"),
+        ("worker.Service.Name", "Service"),
+    ]
+    assert all(item["children"] == [] for item in service["children"])
+    assert "children" not in file_symbols_payload(group)["symbols"][0]
+
+
+def test_grouped_markdown_renders_methods_under_their_type() -> None:
+    symbols = tuple(GoLexicalAdapter().outline("worker/service.go", _SOURCE))
+    group = FileSymbols(path="worker/service.go", language="go_lexical", symbols=symbols)
+
+    rendered = render_markdown_overview([group], group_by_type=True)
+
+    assert "    - [worker.Service.Run](#worker-service-go-worker-service-run)" in rendered
+    assert "##### `worker.Service.Run`" in rendered
+    assert "_method, pointer receiver `*Service`, line 8_" in rendered
+    assert "_method, value receiver `Service`, line 10_" in rendered
+    functions = rendered.split("### Functions", 1)[1]
+    assert "`worker.Client.Dial`" in functions
+    assert "`worker.Build`" in functions
+    assert "`worker.Service.Run`" not in functions
+    assert rendered == render_markdown_overview([group], group_by_type=True)
@@ -0,0 +1,56 @@
+from __future__ import annotations
+
+import io
+import json
+
+from repo_mcp.adapters import OutlineSymbol
+from repo_mcp.symbols import FileSymbols, JsonlSymbolWriter
+
+
+def _group(path: str, count: int) -> FileSymbols:
+    symbols = tuple(
+        OutlineSymbol(
+            kind="function",
+            name=f"fn_{index}",
+            signature="()",
+            start_line=index + 1,
+            end_line=index + 1,
+            doc=None,
+        )
+        for index in range(count)
+    )
+    return FileSymbols(path=path, language="python", symbols=symbols)
+
+
+def test_jsonl_writer_emits_one_complete_line_per_group() -> None:
+    handle = io.StringIO()
+    writer = JsonlSymbolWriter(handle)
+
+    writer.write(_group("src/a.py", 2))
+    writer.write(_group("src/b.py", 1))
+
+    lines = handle.getvalue().splitlines()
+    assert [json.loads(line)["path"] for line in lines] == ["src/a.py", "src/b.py"]
+    assert [len(json.loads(line)["symbols"]) for line in lines] == [2, 1]
+    assert writer.groups_written == 2
+    assert writer.symbols_written == 3
+
+
+class _FlushCounter(io.StringIO):
+    def __init__(self) -> None:
+        super().__init__()
+        self.flushed_lines: list[int] = []
+
+    def flush(self) -> None:
+        self.flushed_lines.append(self.getvalue().count("\n"))
+        super().flush()
+
+
+def test_jsonl_writer_flushes_after_every_line() -> None:
+    handle = _FlushCounter()
+    writer = JsonlSymbolWriter(handle)
+
+    for index in range(3):
+        writer.write(_group(f"src/mod_{index}.py", 1))
+
+    assert handle.flushed_lines == [1, 2, 3]
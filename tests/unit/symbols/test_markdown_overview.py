@@ -0,0 +1,54 @@
+from __future__ import annotations
+
+from pathlib import Path
+
+from repo_mcp.adapters import GoLexicalAdapter, OutlineSymbol
+from repo_mcp.symbols import FileSymbols, render_markdown_overview
+
+
+def _go_group(path: str, fixture: str) -> FileSymbols:
+    text = (Path("tests/fixtures/adapters/go") / fixture).read_text(encoding="utf-8")
+    symbols = tuple(GoLexicalAdapter().outline(path, text))
+    return FileSymbols(path=path, language="go_lexical", symbols=symbols)
+
+
+def test_markdown_overview_matches_go_golden() -> None:
+    groups = [_go_group("pkg/generics.go", "generics.go"), _go_group("pkg/sample.go", "sample.go")]
+
+    rendered = render_markdown_overview(groups)
+
+    golden = Path("tests/fixtures/symbols/go_overview.md").read_text(encoding="utf-8")
+    assert rendered == golden
+    assert rendered == render_markdown_overview(groups)
+
+
+def test_markdown_overview_places_private_symbols_in_internal_block() -> None:
+    symbols = tuple(
+        OutlineSymbol(
+            kind=kind,
+            name=name,
+            signature=None,
+            start_line=line,
+            end_line=line,
+            doc=None,
+            visibility=visibility,
+        )
+        for line, (kind, name, visibility) in enumerate(
+            [
+                ("function", "Run", "public"),
+                ("function", "helper", "private"),
+                ("function", "Run", "public"),
+            ],
+            start=1,
+        )
+    )
+    rendered = render_markdown_overview(
+        [FileSymbols(path="src/a.go", language="go_lexical", symbols=symbols)]
+    )
+
+    public_part, internal_part = rendered.split("<details>")
+    assert "`helper`" not in public_part
+    assert "`helper`" in internal_part
+    assert '<a id="src-a-go-run"></a>' in public_part
+    assert '<a id="src-a-go-run-2"></a>' in public_part
+    assert "- [helper (internal)](#src-a-go-helper)" in rendered
@@ -0,0 +1,118 @@
+from __future__ import annotations
+
+import json
+from pathlib import Path
+
+from repo_mcp.adapters import GoLexicalAdapter
+from repo_mcp.symbols import FileSymbols, link_package_symbols, write_symbol_export
+
+
+def _group(path: str, text: str) -> FileSymbols:
+    symbols = tuple(GoLexicalAdapter().outline(path, text))
+    return FileSymbols(path=path, language="go_lexical", symbols=symbols)
+
+
+def _implements(groups: list[FileSymbols]) -> dict[str, tuple[str, ...] | None]:
+    return {
+        symbol.name: symbol.implements
+        for group in groups
+        for symbol in group.symbols
+        if symbol.kind == "type"
+    }
+
+
+def test_implements_matches_interfaces_and_methods_across_package_files(tmp_path: Path) -> None:
+    runner = _group(
+        "worker/runner.go",
+        "package worker\n\ntype Runner interface {\n\tRun() error\n\tNamer\n}\n\n"
+        "type Namer interface {\n\tName() string\n}\n",
+    )
+    service = _group("worker/service.go", "package worker\n\ntype Service struct {\n\tBase\n}\n")
+    run = _group(
+        "worker/run.go",
+        "package worker\n\ntype Base struct{}\n\n"
+        'func (Base) Name() string { return "base" }\n\n'
+        "func (s *Service) Run() error { return nil }\n",
+    )
+    other = _group("other/service.go", "package worker\n\ntype Service struct{}\n")
+
+    assert _implements([runner, service, run, other])["worker.Service"] is None
+
+    linked = link_package_symbols([service, runner, run, other])
+
+    assert [group.path for group in linked] == [
+        "worker/service.go",
+        "worker/runner.go",
+        "worker/run.go",
+        "other/service.go",
+    ]
+    assert _implements(linked[:3]) == {
+        "worker.Base": ("worker.Namer",),
+        "worker.Namer": None,
+        "worker.Runner": None,
+        "worker.Service": ("worker.Namer", "*worker.Runner"),
+    }
+    assert linked[1] == runner
+    assert linked[3] == other
+
+    destination = tmp_path / "symbols.json"
+    write_symbol_export([runner, run, service], destination, "json")
+    document = json.loads(destination.read_text(encoding="utf-8"))
+    exported = {
+        symbol["name"]: symbol["implements"]
+        for group in document["files"]
+        for symbol in group["symbols"]
+        if symbol["kind"] == "type"
+    }
+    assert exported["worker.Service"] == ["worker.Namer", "*worker.Runner"]
+
+
+def test_calls_resolve_against_declarations_in_sibling_files(tmp_path: Path) -> None:
+    build = _group(
+        "worker/build.go",
+        "package worker\n\ntype Mode int\n\ntype Service struct{}\n\n"
+        "func Build(name string) *Service { return &Service{} }\n\n"
+        "func (s *Service) stop() {}\n",
+    )
+    start = _group(
+        "worker/start.go",
+        'package worker\n\nimport "fmt"\n\n'
+        'func Start() { Build("x"); _ = Mode(1); helper() }\n\n'
+        "func (s *Service) Run() { s.stop(); fmt.Println(s); s.missing() }\n",
+    )
+
+    assert {symbol.name: symbol.calls for symbol in start.symbols} == {
+        "worker.Start": ("Build", "Mode", "helper"),
+        "worker.Service.Run": ("s.stop", "fmt.Println", "s.missing"),
+    }
+
+    linked = link_package_symbols([start, build])
+
+    assert {symbol.name: symbol.calls for symbol in linked[0].symbols} == {
+        "worker.Start": ("worker.Build", "helper"),
+        "worker.Service.Run": ("worker.Service.stop", "fmt.Println", "s.missing"),
+    }
+    assert linked[1] == build
+
+    destination = tmp_path / "symbols.dot"
+    write_symbol_export([build, start], destination, "dot", graph_level="symbol")
+    text = destination.read_text(encoding="utf-8")
+    assert '  "worker.Start" -> "worker.Build" [style="dashed", tooltip="calls"];' in text
+
+
+def test_calls_through_non_receiver_variables_stay_as_written() -> None:
+    service = _group(
+        "worker/service.go",
+        "package worker\n\ntype Service struct {\n\tpeer *Service\n}\n\n"
+        "func (s *Service) Do() { p := s.peer; p.Run() }\n",
+    )
+    run = _group("worker/run.go", "package worker\n\nfunc (s *Service) Run() {}\n")
+
+    assert {symbol.name: symbol.receiver_name for symbol in run.symbols} == {
+        "worker.Service.Run": "s"
+    }
+
+    linked = link_package_symbols([service, run])
+
+    calls = {symbol.name: symbol.calls for symbol in linked[0].symbols}
+    assert calls["worker.Service.Do"] == ("p.Run",)
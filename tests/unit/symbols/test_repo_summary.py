@@ -0,0 +1,102 @@
+from __future__ import annotations
+
+from repo_mcp.adapters import OutlineSymbol
+from repo_mcp.symbols import (
+    FileSymbols,
+    render_summary_markdown,
+    repo_summary_payload,
+    summarize_symbols,
+)
+
+
+def _symbol(
+    kind: str, name: str, *, visibility: str | None, package: str | None = None
+) -> OutlineSymbol:
+    return OutlineSymbol(
+        kind=kind,
+        name=name,
+        signature=None,
+        start_line=1,
+        end_line=1,
+        doc=None,
+        visibility=visibility,
+        package=package,
+    )
+
+
+def _groups() -> list[FileSymbols]:
+    return [
+        FileSymbols(
+            path="pkg/client/client.go",
+            language="go_lexical",
+            symbols=(
+                _symbol("type", "client.Client", visibility="public", package="client"),
+                _symbol("function", "client.dial", visibility="private", package="client"),
+            ),
+            line_count=40,
+        ),
+        FileSymbols(
+            path="pkg/client/retry.go",
+            language="go_lexical",
+            symbols=(_symbol("function", "client.Retry", visibility="public", package="client"),),
+            line_count=12,
+        ),
+        FileSymbols(
+            path="docs/guide.md",
+            language="lexical_fallback",
+            symbols=(_symbol("heading", "Guide", visibility=None),),
+            line_count=40,
+        ),
+    ]
+
+
+def test_summarize_symbols_counts_languages_kinds_and_packages() -> None:
+    summary = summarize_symbols(_groups(), largest_files=2)
+
+    payload = repo_summary_payload(summary)
+
+    assert payload == {
+        "files": 3,
+        "lines": 92,
+        "symbols": 4,
+        "exported": 2,
+        "unexported": 1,
+        "languages": [
+            {"language": "go_lexical", "files": 2, "lines": 52, "symbols": 3},
+            {"language": "lexical_fallback", "files": 1, "lines": 40, "symbols": 1},
+        ],
+        "kinds": {"function": 2, "heading": 1, "type": 1},
+        "packages": [
+            {
+                "package": "client",
+                "files": 2,
+                "symbols": 3,
+                "exported": 2,
+                "unexported": 1,
+                "kinds": {"function": 2, "type": 1},
+            },
+            {
+                "package": "docs",
+                "files": 1,
+                "symbols": 1,
+                "exported": 0,
+                "unexported": 0,
+                "kinds": {"heading": 1},
+            },
+        ],
+        "largest_files": [
+            {"path": "docs/guide.md", "language": "lexical_fallback", "lines": 40},
+            {"path": "pkg/client/client.go", "language": "go_lexical", "lines": 40},
+        ],
+    }
+
+
+def test_render_summary_markdown_writes_one_table_per_breakdown() -> None:
+    text = render_summary_markdown(summarize_symbols(_groups()))
+
+    assert text.startswith("# Repository Summary\n\n- Files: 3\n- Lines: 92\n")
+    assert "- Symbols: 4 (2 exported, 1 unexported)\n" in text
+    assert "| go_lexical | 2 | 52 | 3 |\n" in text
+    assert "| client | 2 | 3 | 2 | 1 | function 2, type 1 |\n" in text
+    assert text.endswith("| pkg/client/retry.go | go_lexical | 12 |\n")
+    assert summarize_symbols([]).files == 0
@@ -0,0 +1,111 @@
+from __future__ import annotations
+
+import pytest
+
+from repo_mcp.adapters import OutlineSymbol
+from repo_mcp.symbols import (
+    LINT_RULES,
+    FileSymbols,
+    LintRule,
+    lint_symbols,
+    render_sarif,
+)
+
+
+def _symbol(
+    name: str,
+    *,
+    line: int,
+    doc: str | None = None,
+    visibility: str | None = "public",
+    kind: str = "function",
+) -> OutlineSymbol:
+    return OutlineSymbol(
+        kind=kind,
+        name=name,
+        signature="()",
+        start_line=line,
+        end_line=line + 1,
+        doc=doc,
+        visibility=visibility,
+        start_col=6,
+    )
+
+
+def _groups() -> list[FileSymbols]:
+    return [
+        FileSymbols(
+            path="pkg/worker.go",
+            language="go_lexical",
+            symbols=(
+                _symbol("worker.Build", line=3),
+                _symbol("worker.Stop", line=7, doc="Stop halts the worker. TODO: drain queue."),
+                _symbol("worker.helper", line=11, visibility="private"),
+                _symbol("worker.Run", line=15, doc="Run starts work."),
+            ),
+        ),
+        FileSymbols(
+            path="src/lib.rs",
+            language="rust_lexical",
+            symbols=(
+                _symbol("Engine", line=1, kind="struct"),
+                _symbol("Engine.run", line=4, doc="FIXME later", kind="method"),
+            ),
+        ),
+    ]
+
+
+def test_lint_symbols_applies_builtin_rules_in_stable_order() -> None:
+    findings = lint_symbols(_groups())
+
+    assert [(item.path, item.start_line, item.rule_id) for item in findings] == [
+        ("pkg/worker.go", 3, "undocumented-exported-symbol"),
+        ("pkg/worker.go", 7, "todo-in-doc-comment"),
+        ("src/lib.rs", 4, "todo-in-doc-comment"),
+    ]
+    assert findings[0].message == "Exported function `worker.Build` has no doc comment."
+    assert findings[1].message == "Doc comment of `worker.Stop` contains TODO."
+    assert findings[2].message == "Doc comment of `Engine.run` contains FIXME."
+
+
+def test_lint_symbols_accepts_extra_rules_and_rejects_duplicates() -> None:
+    long_names = LintRule(
+        rule_id="long-name",
+        summary="Symbol name is longer than 12 characters.",
+        level="error",
+        check=lambda symbol: "too long" if len(symbol.name) > 12 else None,
+        languages=frozenset({"go_lexical"}),
+    )
+
+    findings = lint_symbols(_groups(), (*LINT_RULES, long_names))
+
+    assert [(item.symbol, item.rule_id) for item in findings if item.rule_id == "long-name"] == [
+        ("worker.helper", "long-name")
+    ]
+    with pytest.raises(ValueError, match="unique"):
+        lint_symbols(_groups(), (long_names, long_names))
+
+
+def test_render_sarif_emits_rules_and_results_with_positions() -> None:
+    findings = lint_symbols(_groups())
+
+    log = render_sarif(findings, LINT_RULES)
+
+    assert log["version"] == "2.1.0"
+    [run] = log["runs"]
+    driver = run["tool"]["driver"]
+    assert driver["name"] == "repo-interrogator"
+    assert [rule["id"] for rule in driver["rules"]] == [
+        "undocumented-exported-symbol",
+        "todo-in-doc-comment",
+    ]
+    first = run["results"][0]
+    assert first["ruleId"] == "undocumented-exported-symbol"
+    assert first["ruleIndex"] == 0
+    assert first["level"] == "warning"
+    assert first["locations"][0]["physicalLocation"] == {
+        "artifactLocation": {"uri": "pkg/worker.go", "uriBaseId": "%SRCROOT%"},
+        "region": {"startLine": 3, "endLine": 4, "startColumn": 6},
+    }
+    assert first["locations"][0]["logicalLocations"] == [{"fullyQualifiedName": "worker.Build"}]
+    assert len(run["results"]) == 3
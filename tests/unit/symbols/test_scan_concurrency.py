@@ -0,0 +1,148 @@
+from __future__ import annotations
+
+import os
+from dataclasses import replace
+from pathlib import Path
+
+from repo_mcp.adapters import AdapterRegistry, LexicalFallbackAdapter, PythonAstAdapter
+from repo_mcp.adapters.base import OutlineSymbol
+from repo_mcp.config import default_config
+from repo_mcp.symbols import Diagnostic, scan_repository_symbols
+
+
+class _ExplodingAdapter(PythonAstAdapter):
+    def outline(self, path: str, text: str) -> list[OutlineSymbol]:
+        if path.endswith("mod_007.py"):
+            raise RuntimeError("adapter crashed")
+        return super().outline(path, text)
+
+
+class _PidAdapter(PythonAstAdapter):
+    def outline(self, path: str, text: str) -> list[OutlineSymbol]:
+        return [replace(symbol, doc=str(os.getpid())) for symbol in super().outline(path, text)]
+
+
+class _WorkerFaultAdapter(PythonAstAdapter):
+    """Fails on mod_007.py in worker processes only: by raising, or by exiting the worker."""
+
+    def __init__(self, *, exit_worker: bool) -> None:
+        self._parent_pid = os.getpid()
+        self._exit_worker = exit_worker
+
+    def supports_path(self, path: str) -> bool:
+        if path.endswith("mod_007.py") and os.getpid() != self._parent_pid:
+            if self._exit_worker:
+                os._exit(1)
+            raise RuntimeError("worker fault")
+        return super().supports_path(path)
+
+
+def _write_modules(root: Path, count: int) -> None:
+    (root / "src").mkdir()
+    for index in range(count):
+        body = "".join(
+            f"def fn_{index}_{item}() -> None:\n    pass\n" for item in range(index % 5 + 1)
+        )
+        (root / "src" / f"mod_{index:03d}.py").write_text(body, encoding="utf-8")
+
+
+def _registry(adapter: PythonAstAdapter) -> AdapterRegistry:
+    registry = AdapterRegistry()
+    registry.register(adapter)
+    registry.register(LexicalFallbackAdapter(), fallback=True)
+    return registry
+
+
+def _scan(root: Path, registry: AdapterRegistry, concurrency: int) -> list[tuple[str, list[str]]]:
+    config = default_config(root)
+    groups = scan_repository_symbols(
+        repo_root=config.repo_root,
+        index_config=config.index,
+        limits=config.limits,
+        adapters=registry,
+        concurrency=concurrency,
+    )
+    return [(group.path, [symbol.name for symbol in group.symbols]) for group in groups]
+
+
+def test_scan_output_is_identical_across_worker_counts(tmp_path: Path) -> None:
+    _write_modules(tmp_path, 30)
+    registry = _registry(PythonAstAdapter())
+
+    serial = _scan(tmp_path, registry, concurrency=1)
+    parallel = _scan(tmp_path, registry, concurrency=8)
+
+    assert serial == parallel
+    assert [path for path, _ in serial] == sorted(path for path, _ in serial)
+    assert len(serial) == 30
+
+
+def test_scan_failure_on_one_file_keeps_other_results(tmp_path: Path) -> None:
+    _write_modules(tmp_path, 12)
+    config = default_config(tmp_path)
+    profile: dict[str, object] = {}
+
+    groups = list(
+        scan_repository_symbols(
+            repo_root=config.repo_root,
+            index_config=config.index,
+            limits=config.limits,
+            adapters=_registry(_ExplodingAdapter()),
+            concurrency=4,
+            profile=profile,
+        )
+    )
+
+    paths = [group.path for group in groups]
+    assert "src/mod_007.py" not in paths
+    assert len(paths) == 11
+    assert profile["files_failed"] == 1
+    assert profile["files_discovered"] == 12
+    assert profile["concurrency"] == 4
+
+
+def test_scan_parses_in_worker_processes_unless_single_worker(tmp_path: Path) -> None:
+    _write_modules(tmp_path, 40)
+    config = default_config(tmp_path)
+
+    def parser_pids(concurrency: int) -> set[str]:
+        groups = scan_repository_symbols(
+            repo_root=config.repo_root,
+            index_config=config.index,
+            limits=config.limits,
+            adapters=_registry(_PidAdapter()),
+            concurrency=concurrency,
+        )
+        return {symbol.doc or "" for group in groups for symbol in group.symbols}
+
+    assert parser_pids(1) == {str(os.getpid())}
+    assert str(os.getpid()) not in parser_pids(2)
+
+    for path in (tmp_path / "src").glob("mod_0[0-2]*.py"):
+        path.unlink()
+    assert parser_pids(2) == {str(os.getpid())}
+
+
+def test_scan_reports_worker_faults_as_failed_files(tmp_path: Path) -> None:
+    _write_modules(tmp_path, 40)
+    config = default_config(tmp_path)
+
+    for exit_worker in (False, True):
+        profile: dict[str, object] = {}
+        diagnostics: list[Diagnostic] = []
+        groups = list(
+            scan_repository_symbols(
+                repo_root=config.repo_root,
+                index_config=config.index,
+                limits=config.limits,
+                adapters=_registry(_WorkerFaultAdapter(exit_worker=exit_worker)),
+                concurrency=2,
+                profile=profile,
+                diagnostics=diagnostics,
+            )
+        )
+
+        assert profile["files_failed"] == 1
+        assert len(groups) == 39
+        error = "BrokenProcessPool" if exit_worker else "RuntimeError"
+        assert [item.message for item in diagnostics] == [f"python adapter failed: {error}"]
@@ -0,0 +1,77 @@
+from __future__ import annotations
+
+import io
+
+from repo_mcp.symbols import ProgressReporter, progress_enabled
+
+
+class _Clock:
+    def __init__(self) -> None:
+        self.now = 0.0
+
+    def __call__(self) -> float:
+        return self.now
+
+
+class _TtyStream(io.StringIO):
+    def isatty(self) -> bool:
+        return True
+
+
+def test_progress_reporter_throttles_log_lines_but_always_writes_first_and_last() -> None:
+    stream = io.StringIO()
+    clock = _Clock()
+    reporter = ProgressReporter(stream, tty=False, interval_seconds=2.0, clock=clock)
+
+    for done in range(0, 5):
+        reporter(done, 4)
+        clock.now += 0.5
+    assert stream.getvalue() == (
+        "Scanning symbols: 0/4 files (0%)\n"
+        "Scanning symbols: 4/4 files (100%)\n"
+    )
+
+    stream = io.StringIO()
+    clock = _Clock()
+    reporter = ProgressReporter(stream, tty=False, interval_seconds=2.0, clock=clock)
+    for done in range(0, 5):
+        reporter(done, 4)
+        clock.now += 1.0
+    assert stream.getvalue().splitlines() == [
+        "Scanning symbols: 0/4 files (0%)",
+        "Scanning symbols: 2/4 files (50%)",
+        "Scanning symbols: 4/4 files (100%)",
+    ]
+
+
+def test_progress_reporter_redraws_terminal_line_with_spinner() -> None:
+    stream = io.StringIO()
+    clock = _Clock()
+    reporter = ProgressReporter(stream, tty=True, interval_seconds=0.1, clock=clock)
+
+    reporter(0, 3)
+    clock.now += 0.2
+    reporter(1, 3)
+    clock.now += 0.2
+    reporter(3, 3)
+
+    assert stream.getvalue() == (
+        "\rScanning symbols: 0/3 files (0%) |"
+        "\rScanning symbols: 1/3 files (33%) /"
+        "\rScanning symbols: 3/3 files (100%) done\n"
+    )
+
+
+def test_progress_reporter_finishes_empty_scans() -> None:
+    stream = io.StringIO()
+
+    ProgressReporter(stream, tty=False)(0, 0)
+
+    assert stream.getvalue() == "Scanning symbols: 0/0 files (100%)\n"
+
+
+def test_progress_enabled_follows_mode_and_terminal_detection() -> None:
+    assert progress_enabled("always", io.StringIO()) is True
+    assert progress_enabled("never", _TtyStream()) is False
+    assert progress_enabled("auto", _TtyStream()) is True
+    assert progress_enabled("auto", io.StringIO()) is False
@@ -0,0 +1,115 @@
+from __future__ import annotations
+
+import sqlite3
+from contextlib import closing
+from pathlib import Path
+
+import pytest
+
+from repo_mcp.adapters import GoLexicalAdapter
+from repo_mcp.symbols import (
+    SQLITE_SCHEMA_VERSION,
+    FileSymbols,
+    write_sqlite_export,
+    write_symbol_export,
+)
+
+_SERVICE = (
+    "package worker\n\n"
+    'import "fmt"\n\n'
+    "type Service struct {\n"
+    "\tName string\n"
+    "}\n\n"
+    "func (s *Service) Run() { helper(); fmt.Println(s.Name) }\n\n"
+    "func helper() {}\n"
+)
+
+
+def _group(path: str, source: str) -> FileSymbols:
+    adapter = GoLexicalAdapter()
+    return FileSymbols(
+        path=path,
+        language=adapter.name,
+        symbols=tuple(adapter.outline(path, source)),
+        imports=tuple(adapter.imports(path, source) or ()),
+    )
+
+
+def _rows(destination: Path, query: str) -> list[tuple[object, ...]]:
+    with closing(sqlite3.connect(destination)) as connection:
+        return connection.execute(query).fetchall()
+
+
+def test_sqlite_export_writes_symbols_edges_and_imports(tmp_path: Path) -> None:
+    destination = tmp_path / "repo.db"
+
+    summary = write_symbol_export(
+        [_group("worker/service.go", _SERVICE), FileSymbols("docs/a.md", "lexical", ())],
+        destination,
+        "sqlite",
+    )
+
+    assert (summary.files_scanned, summary.files_exported, summary.symbol_count) == (2, 1, 4)
+    assert _rows(destination, "PRAGMA user_version") == [(SQLITE_SCHEMA_VERSION,)]
+    assert _rows(destination, "SELECT path, language FROM files") == [
+        ("worker/service.go", "go_lexical")
+    ]
+    assert _rows(
+        destination,
+        "SELECT name, kind, visibility, file, start_line, package FROM symbols "
+        "ORDER BY start_line",
+    ) == [
+        ("worker.Service", "type", "public", "worker/service.go", 5, "worker"),
+        ("worker.Service.Name", "field", "public", "worker/service.go", 6, "worker"),
+        ("worker.Service.Run", "method", "public", "worker/service.go", 9, "worker"),
+        ("worker.helper", "function", "private", "worker/service.go", 11, "worker"),
+    ]
+    assert _rows(
+        destination,
+        "SELECT source.name, edges.kind, edges.target, target.name FROM edges "
+        "JOIN symbols AS source ON source.id = edges.source_id "
+        "LEFT JOIN symbols AS target ON target.id = edges.target_id "
+        "ORDER BY source.name, edges.kind, edges.target",
+    ) == [
+        ("worker.Service", "child", "worker.Service.Name", "worker.Service.Name"),
+        ("worker.Service", "child", "worker.Service.Run", "worker.Service.Run"),
+        ("worker.Service.Run", "calls", "fmt.Println", None),
+        ("worker.Service.Run", "calls", "worker.helper", "worker.helper"),
+    ]
+    assert _rows(
+        destination,
+        "SELECT symbols.name, imports.path FROM symbols "
+        "JOIN imports ON imports.file = symbols.file WHERE symbols.kind = 'method'",
+    ) == [("worker.Service.Run", "fmt")]
+
+
+def test_sqlite_export_rerun_upserts_by_symbol_id(tmp_path: Path) -> None:
+    destination = tmp_path / "repo.db"
+    write_sqlite_export([_group("worker/service.go", _SERVICE)], destination)
+    ids = dict(_rows(destination, "SELECT name, id FROM symbols"))
+
+    changed = _SERVICE.replace("func helper() {}\n", "").replace("helper(); ", "")
+    write_sqlite_export([_group("worker/service.go", "// Moved.\n" + changed)], destination)
+
+    rows = _rows(destination, "SELECT name, id, start_line FROM symbols ORDER BY start_line")
+    assert rows == [
+        ("worker.Service", ids["worker.Service"], 6),
+        ("worker.Service.Name", ids["worker.Service.Name"], 7),
+        ("worker.Service.Run", ids["worker.Service.Run"], 10),
+    ]
+    assert _rows(destination, "SELECT kind, target FROM edges WHERE kind = 'calls'") == [
+        ("calls", "fmt.Println")
+    ]
+
+
+def test_sqlite_export_rejects_files_that_are_not_export_databases(tmp_path: Path) -> None:
+    notes = tmp_path / "notes.db"
+    notes.write_text("not a database\n", encoding="utf-8")
+    with pytest.raises(OSError, match="SQLite export failed"):
+        write_sqlite_export([], notes)
+
+    other = tmp_path / "other.db"
+    with closing(sqlite3.connect(other)) as connection:
+        connection.execute("PRAGMA user_version = 99")
+    with pytest.raises(OSError, match="unsupported SQLite export schema version 99"):
+        write_sqlite_export([], other)
@@ -0,0 +1,64 @@
+from __future__ import annotations
+
+from pathlib import Path
+
+from repo_mcp.adapters import FileImport, OutlineSymbol
+from repo_mcp.index.models import FileRecord
+from repo_mcp.symbols import CachedFileSymbols, FileSymbols, load_symbol_cache, write_symbol_cache
+
+
+def _entry(path: str) -> CachedFileSymbols:
+    symbol = OutlineSymbol(
+        kind="function",
+        name="run",
+        signature="(value: int)",
+        start_line=3,
+        end_line=4,
+        doc=None,
+        decorators=("staticmethod",),
+        visibility="public",
+        start_col=5,
+    )
+    return CachedFileSymbols(
+        record=FileRecord(path=path, size=10, mtime_ns=20, content_hash="abc"),
+        group=FileSymbols(
+            path=path,
+            language="python",
+            symbols=(symbol,),
+            imports=(FileImport(path="os", name=None, alias=None, line=1, used=False),),
+        ),
+    )
+
+
+def test_symbol_cache_round_trips_entries_sorted_by_path(tmp_path: Path) -> None:
+    cache_path = tmp_path / "cache" / "symbols.json"
+    write_symbol_cache(cache_path, [_entry("src/z.py"), _entry("src/a.py")])
+
+    loaded = load_symbol_cache(cache_path)
+
+    assert list(loaded) == ["src/a.py", "src/z.py"]
+    assert loaded["src/a.py"] == _entry("src/a.py")
+    assert not cache_path.with_suffix(".json.tmp").exists()
+
+
+def test_symbol_cache_ignores_missing_corrupt_and_stale_versions(tmp_path: Path) -> None:
+    cache_path = tmp_path / "symbols.json"
+    assert load_symbol_cache(cache_path) == {}
+
+    cache_path.write_text("{not json", encoding="utf-8")
+    assert load_symbol_cache(cache_path) == {}
+
+    cache_path.write_text('{"cache_version": 0, "files": []}', encoding="utf-8")
+    assert load_symbol_cache(cache_path) == {}
+
+    cache_path.write_text('{"cache_version": 24, "files": [{"record": {}}]}', encoding="utf-8")
+    assert load_symbol_cache(cache_path) == {}
+
+
+def test_symbol_cache_ignores_entries_written_under_another_redaction(tmp_path: Path) -> None:
+    cache_path = tmp_path / "symbols.json"
+    write_symbol_cache(cache_path, [_entry("src/a.py")], redaction="0123456789abcdef")
+
+    assert load_symbol_cache(cache_path) == {}
+    assert load_symbol_cache(cache_path, redaction="fedcba9876543210") == {}
+    assert list(load_symbol_cache(cache_path, redaction="0123456789abcdef")) == ["src/a.py"]
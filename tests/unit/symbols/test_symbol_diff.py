@@ -0,0 +1,200 @@
+from __future__ import annotations
+
+import json
+from dataclasses import replace
+from pathlib import Path
+
+import pytest
+
+from repo_mcp.adapters import GoLexicalAdapter, OutlineSymbol
+from repo_mcp.symbols import (
+    FileSymbols,
+    diff_failed,
+    diff_symbols,
+    file_symbols_payload,
+    parse_symbol_export,
+    symbol_change_payload,
+)
+
+
+def _symbol(
+    kind: str,
+    name: str,
+    signature: str | None = None,
+    *,
+    visibility: str = "public",
+    start_line: int = 1,
+) -> OutlineSymbol:
+    return OutlineSymbol(
+        kind=kind,
+        name=name,
+        signature=signature,
+        start_line=start_line,
+        end_line=start_line,
+        doc=None,
+        visibility=visibility,
+    )
+
+
+def _group(*symbols: OutlineSymbol) -> FileSymbols:
+    return FileSymbols(path="worker/worker.go", language="go_lexical", symbols=symbols)
+
+
+def test_diff_symbols_classifies_added_removed_and_changed() -> None:
+    old = [
+        _group(
+            _symbol("function", "worker.Build", "(name string)"),
+            _symbol("function", "worker.Stop", "()"),
+            _symbol("function", "worker.helper", "()", visibility="private"),
+            _symbol("type", "worker.Service", start_line=5),
+        )
+    ]
+    new = [
+        _group(
+            _symbol("function", "worker.Build", "(name string, retries int)"),
+            _symbol("function", "worker.Start", "()"),
+            _symbol("type", "worker.Service", start_line=9),
+        )
+    ]
+
+    diff = diff_symbols(old, new)
+
+    assert [entry.name for entry in diff.added] == ["worker.Start"]
+    assert [(entry.name, entry.breaking) for entry in diff.removed] == [
+        ("worker.Stop", True),
+        ("worker.helper", False),
+    ]
+    assert [symbol_change_payload(entry) for entry in diff.changed] == [
+        {
+            "path": "worker/worker.go",
+            "kind": "function",
+            "name": "worker.Build",
+            "breaking": True,
+            "before": {
+                "signature": "(name string)",
+                "canonical_signature": None,
+                "returns": None,
+                "visibility": "public",
+            },
+            "after": {
+                "signature": "(name string, retries int)",
+                "canonical_signature": None,
+                "returns": None,
+                "visibility": "public",
+            },
+        }
+    ]
+    assert diff.breaking_count == 2
+    assert diff_failed(diff, "breaking")
+    assert diff_failed(diff, "any")
+    assert not diff_failed(diff, "none")
+
+
+def test_diff_symbols_flags_narrowed_visibility_but_not_private_or_additive_changes() -> None:
+    old = [
+        _group(
+            _symbol("function", "worker.Build", "()"),
+            _symbol("function", "worker.helper", "()", visibility="private"),
+        )
+    ]
+    new = [
+        _group(
+            _symbol("function", "worker.Build", "()", visibility="private"),
+            _symbol("function", "worker.helper", "(n int)", visibility="private"),
+            _symbol("function", "worker.Extra", "()"),
+        )
+    ]
+
+    diff = diff_symbols(old, new)
+
+    assert [(entry.name, entry.breaking) for entry in diff.changed] == [
+        ("worker.Build", True),
+        ("worker.helper", False),
+    ]
+    assert diff.breaking_count == 1
+    assert not diff_failed(diff_symbols(old, old), "any")
+
+
+def test_diff_symbols_compares_go_signatures_in_canonical_form() -> None:
+    adapter = GoLexicalAdapter()
+    fixtures = Path("tests/fixtures/adapters/go")
+    path = "worker/signatures.go"
+    written = adapter.outline(path, (fixtures / "signatures.go").read_text(encoding="utf-8"))
+    restyled_text = (fixtures / "signatures_restyled.go").read_text(encoding="utf-8")
+    restyled = adapter.outline(path, restyled_text)
+    old = [FileSymbols(path=path, language="go_lexical", symbols=tuple(written))]
+
+    unchanged = diff_symbols(old, [replace(old[0], symbols=tuple(restyled))])
+
+    assert [symbol.signature for symbol in written] != [symbol.signature for symbol in restyled]
+    assert (unchanged.added, unchanged.removed, unchanged.changed) == ((), (), ())
+
+    edited = adapter.outline(path, restyled_text.replace("n int) (int", "n int64) (int"))
+    diff = diff_symbols(old, [replace(old[0], symbols=tuple(edited))])
+    assert [(entry.name, entry.breaking) for entry in diff.changed] == [("worker.Copy", True)]
+    after = symbol_change_payload(diff.changed[0])["after"]
+    assert after == {
+        "signature": "(dst []byte, src []byte, n int64)",
+        "canonical_signature": "(dst []byte, src []byte, n int64) (int, error)",
+        "returns": ["int", "error"],
+        "visibility": "public",
+    }
+
+
+def test_diff_symbols_matches_symbols_moved_between_files_of_a_package() -> None:
+    adapter = GoLexicalAdapter()
+    runner = "package worker\n\nfunc Run() {}\n\nfunc Add(a, b int) int { return a + b }\n"
+    old = [
+        FileSymbols(
+            path="worker/runner.go",
+            language="go_lexical",
+            symbols=tuple(adapter.outline("worker/runner.go", runner)),
+        )
+    ]
+    moved = [
+        FileSymbols(
+            path="worker/math.go",
+            language="go_lexical",
+            symbols=tuple(
+                adapter.outline("worker/math.go", "package worker\n\nfunc Add(a, b int) int {}\n")
+            ),
+        ),
+        FileSymbols(
+            path="worker/runner.go",
+            language="go_lexical",
+            symbols=tuple(adapter.outline("worker/runner.go", "package worker\n\nfunc Run() {}\n")),
+        ),
+    ]
+
+    diff = diff_symbols(old, moved)
+
+    assert (diff.added, diff.removed, diff.changed) == ((), (), ())
+    assert not diff_failed(diff, "breaking")
+
+    edited = [
+        replace(
+            moved[0],
+            symbols=tuple(
+                adapter.outline("worker/math.go", "package worker\n\nfunc Add(a int) int {}\n")
+            ),
+        ),
+        moved[1],
+    ]
+    changed = diff_symbols(old, edited).changed
+    assert [(entry.path, entry.name, entry.breaking) for entry in changed] == [
+        ("worker/math.go", "worker.Add", True)
+    ]
+
+
+def test_parse_symbol_export_reads_json_and_jsonl_artifacts() -> None:
+    group = _group(_symbol("function", "worker.Build", "()"))
+    payload = file_symbols_payload(group)
+    document = json.dumps({"export_version": 1, "files": [payload]})
+
+    assert parse_symbol_export(document)[0].symbols == group.symbols
+    assert parse_symbol_export(json.dumps(payload) + "\n")[0].path == "worker/worker.go"
+    assert parse_symbol_export("") == []
+    with pytest.raises(ValueError, match="export_version"):
+        parse_symbol_export(json.dumps({"export_version": 99, "files": []}))
+    with pytest.raises(ValueError):
+        parse_symbol_export("# API Overview\n")
@@ -0,0 +1,102 @@
+from __future__ import annotations
+
+from repo_mcp.adapters import OutlineSymbol
+from repo_mcp.symbols import FileSymbols, PackedFile, char_ratio_estimator, pack_symbols
+
+
+def _symbol(name: str, line: int, *, visibility: str, doc: str | None = None) -> OutlineSymbol:
+    return OutlineSymbol(
+        kind="function",
+        name=name,
+        signature="()",
+        start_line=line,
+        end_line=line,
+        doc=doc,
+        visibility=visibility,
+    )
+
+
+def _groups() -> list[FileSymbols]:
+    return [
+        FileSymbols(
+            path="src/b.go",
+            language="go_lexical",
+            symbols=(
+                _symbol("b.helper", 3, visibility="private"),
+                _symbol("b.Run", 5, visibility="public"),
+            ),
+        ),
+        FileSymbols(
+            path="src/a.go",
+            language="go_lexical",
+            symbols=(
+                _symbol("a.Build", 1, visibility="public", doc="Build makes things."),
+                _symbol("a.internal", 9, visibility="private", doc="Internal detail."),
+            ),
+        ),
+        FileSymbols(path="src/empty.go", language="go_lexical", symbols=()),
+    ]
+
+
+def test_char_ratio_estimator_rounds_up() -> None:
+    estimate = char_ratio_estimator(4)
+    assert [estimate(""), estimate("abc"), estimate("abcd"), estimate("abcde")] == [0, 1, 1, 2]
+
+
+def test_pack_includes_everything_when_budget_allows() -> None:
+    pack = pack_symbols(_groups(), max_tokens=10_000)
+
+    assert pack.text == (
+        "# src/a.go\n"
+        "function a.Build() :1\n"
+        "  Build makes things.\n"
+        "function a.internal() :9\n"
+        "  Internal detail.\n"
+        "# src/b.go\n"
+        "function b.helper() :3\n"
+        "function b.Run() :5\n"
+    )
+    estimate = char_ratio_estimator()
+    assert pack.estimated_tokens == sum(
+        estimate(block)
+        for block in (
+            "# src/a.go\n",
+            "function a.Build() :1\n  Build makes things.\n",
+            "function a.internal() :9\n  Internal detail.\n",
+            "# src/b.go\n",
+            "function b.helper() :3\n",
+            "function b.Run() :5\n",
+        )
+    )
+    assert [item.status for item in pack.files] == ["full", "full"]
+    assert (pack.symbols_included, pack.symbols_total) == (4, 4)
+
+
+def test_pack_prefers_public_documented_symbols_and_stops_at_budget() -> None:
+    estimate = char_ratio_estimator()
+    budget = (
+        estimate("# src/a.go\n")
+        + estimate("function a.Build() :1\n  Build makes things.\n")
+        + estimate("# src/b.go\n")
+        + estimate("function b.Run() :5\n")
+    )
+
+    pack = pack_symbols(_groups(), max_tokens=budget, estimate_tokens=estimate)
+
+    assert pack.text == (
+        "# src/a.go\n"
+        "function a.Build() :1\n"
+        "  Build makes things.\n"
+        "# src/b.go\n"
+        "function b.Run() :5\n"
+    )
+    assert pack.estimated_tokens == budget
+    assert pack.files == (
+        PackedFile(path="src/a.go", status="partial", symbols_included=1, symbols_total=2),
+        PackedFile(path="src/b.go", status="partial", symbols_included=1, symbols_total=2),
+    )
+
+    dropped = pack_symbols(_groups(), max_tokens=1)
+    assert dropped.text == ""
+    assert dropped.estimated_tokens == 0
+    assert [item.status for item in dropped.files] == ["dropped", "dropped"]
@@ -0,0 +1,108 @@
+from __future__ import annotations
+
+from dataclasses import replace
+
+from repo_mcp.adapters import OutlineSymbol
+from repo_mcp.symbols import (
+    FileSymbols,
+    SymbolQuery,
+    kind_allowlist,
+    query_symbols,
+    select_kinds,
+)
+
+
+def _symbol(
+    kind: str,
+    name: str,
+    *,
+    visibility: str = "public",
+    returns: tuple[str, ...] | None = None,
+) -> OutlineSymbol:
+    return OutlineSymbol(
+        kind=kind,
+        name=name,
+        signature=None,
+        start_line=1,
+        end_line=1,
+        doc=None,
+        visibility=visibility,
+        returns=returns,
+    )
+
+
+def _groups() -> list[FileSymbols]:
+    return [
+        FileSymbols(
+            path="cmd/worker/main.go",
+            language="go_lexical",
+            symbols=(
+                _symbol("function", "worker.Build", returns=("*Service", "error")),
+                _symbol("function", "worker.helper", visibility="private", returns=("error",)),
+                _symbol("type", "worker.Service"),
+            ),
+        ),
+        FileSymbols(
+            path="pkg/api/api.go",
+            language="go_lexical",
+            symbols=(_symbol("function", "api.Serve", returns=("error",)),),
+        ),
+        FileSymbols(
+            path="src/worker/jobs.py",
+            language="python",
+            symbols=(_symbol("function", "run", returns=("None",)),),
+        ),
+    ]
+
+
+def _names(groups: list[FileSymbols]) -> list[str]:
+    return [symbol.name for group in groups for symbol in group.symbols]
+
+
+def test_query_combines_kind_visibility_returns_and_package_filters() -> None:
+    query = SymbolQuery(kind="function", visibility="public", returns="error", package="worker")
+
+    assert _names(query_symbols(_groups(), query)) == ["worker.Build"]
+
+
+def test_query_package_matches_qualified_names_or_directory() -> None:
+    assert _names(query_symbols(_groups(), SymbolQuery(package="worker"))) == [
+        "worker.Build",
+        "worker.helper",
+        "worker.Service",
+        "run",
+    ]
+    assert _names(query_symbols(_groups(), SymbolQuery(package="api"))) == ["api.Serve"]
+
+
+def test_query_package_matches_symbol_package_field() -> None:
+    group = FileSymbols(
+        path="src/app/jobs.py",
+        language="python",
+        symbols=(replace(_symbol("function", "run"), package="app.jobs"),),
+    )
+
+    assert _names(query_symbols([group], SymbolQuery(package="app.jobs"))) == ["run"]
+    assert query_symbols([group], SymbolQuery(package="jobs")) == []
+
+
+def test_query_returns_normalizes_whitespace_and_drops_empty_groups() -> None:
+    matched = query_symbols(_groups(), SymbolQuery(returns="* Service"))
+
+    assert [group.path for group in matched] == ["cmd/worker/main.go"]
+    assert _names(matched) == ["worker.Build"]
+    assert query_symbols(_groups(), SymbolQuery(kind="struct")) == []
+
+
+def test_select_kinds_counts_go_interface_types_as_interfaces() -> None:
+    runner = replace(_symbol("type", "worker.Runner"), decl_context="interface")
+    service = replace(_symbol("type", "worker.Service"), decl_context="struct")
+    shape = _symbol("interface", "Shape")
+    group = FileSymbols(path="worker/a.go", language="go_lexical", symbols=(runner, service))
+    other = FileSymbols(path="src/shape.ts", language="ts_js_lexical", symbols=(shape,))
+
+    interfaces = select_kinds([group, other], kind_allowlist(["interface"]))
+    types = select_kinds([group, other], kind_allowlist(["type"]))
+
+    assert [item.symbols for item in interfaces] == [(runner,), (shape,)]
+    assert [item.symbols for item in types] == [(service,), ()]
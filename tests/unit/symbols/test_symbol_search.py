@@ -0,0 +1,97 @@
+from __future__ import annotations
+
+from repo_mcp.adapters import OutlineSymbol
+from repo_mcp.symbols import (
+    FileSymbols,
+    SymbolSearchIndex,
+    render_search_markdown,
+    search_terms,
+)
+
+
+def _symbol(
+    name: str,
+    start_line: int,
+    *,
+    signature: str | None = None,
+    doc: str | None = None,
+) -> OutlineSymbol:
+    return OutlineSymbol(
+        kind="function",
+        name=name,
+        signature=signature,
+        start_line=start_line,
+        end_line=start_line,
+        doc=doc,
+    )
+
+
+def _index() -> SymbolSearchIndex:
+    return SymbolSearchIndex(
+        [
+            FileSymbols(
+                path="pkg/client/client.go",
+                language="go_lexical",
+                symbols=(
+                    _symbol("client.Send", 3, doc="Send posts a request and will retry on 503."),
+                    _symbol("client.RetryPolicy", 9, signature="(maxRetries int)"),
+                    _symbol("client.Retry", 14),
+                ),
+            ),
+            FileSymbols(
+                path="pkg/client/backoff.go",
+                language="go_lexical",
+                symbols=(
+                    _symbol("client.newBackoff", 2, signature="(attempts int)"),
+                    _symbol("client.retrying", 7),
+                ),
+            ),
+        ]
+    )
+
+
+def test_search_terms_split_camel_and_snake_case_words() -> None:
+    assert search_terms("MaxRetries max_retry_count HTTPServer2") == [
+        "maxretries",
+        "max",
+        "retries",
+        "retry",
+        "count",
+        "httpserver2",
+        "http",
+        "server",
+        "2",
+    ]
+
+
+def test_search_ranks_name_matches_above_signature_and_doc_matches() -> None:
+    hits = _index().search("retry")
+
+    assert [(hit.symbol.name, hit.score, hit.matched_fields) for hit in hits] == [
+        ("client.Retry", 12, ("name",)),
+        ("client.RetryPolicy", 8, ("name",)),
+        ("client.retrying", 4, ("name",)),
+        ("client.Send", 2, ("doc",)),
+    ]
+
+
+def test_search_adds_scores_across_query_words_and_breaks_ties_by_path() -> None:
+    hits = _index().search("int attempts")
+
+    assert [(hit.path, hit.symbol.name, hit.score) for hit in hits] == [
+        ("pkg/client/backoff.go", "client.newBackoff", 8),
+        ("pkg/client/client.go", "client.RetryPolicy", 4),
+    ]
+    assert _index().search("nothing-here") == []
+
+
+def test_render_search_markdown_lists_ranked_locations() -> None:
+    hits = _index().search("send")
+
+    assert render_search_markdown("send", hits) == (
+        "# Symbol search: `send`\n"
+        "\n"
+        "1. `client.Send` (function) - `pkg/client/client.go:3` - score 14\n"
+        "   - Send posts a request and will retry on 503.\n"
+    )
+    assert render_search_markdown("zzz", []).endswith("No matching symbols.\n")
@@ -0,0 +1,39 @@
+from __future__ import annotations
+
+from repo_mcp.adapters import GoLexicalAdapter
+from repo_mcp.symbols import FileSymbols, resolve_type_references
+
+
+def _group(path: str, text: str) -> FileSymbols:
+    symbols = tuple(GoLexicalAdapter().outline(path, text))
+    return FileSymbols(path=path, language="go_lexical", symbols=symbols)
+
+
+def test_type_references_resolve_to_ids_within_the_same_package_only() -> None:
+    service = _group("worker/service.go", "package worker\n\ntype Service struct{}\n")
+    build = _group(
+        "worker/build.go",
+        'package worker\n\nimport "context"\n\n'
+        "func Build(ctx context.Context) (*Service, *Missing) { return nil, nil }\n",
+    )
+    other = _group(
+        "other/build.go",
+        "package worker\n\nfunc Build() *Service { return nil }\n",
+    )
+
+    resolved = resolve_type_references([build, service, other])
+
+    assert [group.path for group in resolved] == [
+        "worker/build.go",
+        "worker/service.go",
+        "other/build.go",
+    ]
+    service_id = service.symbols[0].id
+    assert service_id is not None
+    assert resolved[0].symbols[0].references == (
+        "context.Context",
+        service_id,
+        "worker.Missing",
+    )
+    assert resolved[2].symbols[0].references == ("worker.Service",)
+    assert resolved[1] == service
@@ -0,0 +1,83 @@
+from __future__ import annotations
+
+from repo_mcp.index.models import FileRecord
+from repo_mcp.symbols import WatchBatch, watch_changes
+
+
+def _snapshot(**hashes: str) -> dict[str, FileRecord]:
+    return {
+        f"{name}.go": FileRecord(path=f"{name}.go", size=1, mtime_ns=1, content_hash=value)
+        for name, value in hashes.items()
+    }
+
+
+class _Script:
+    """Replays snapshots per poll and advances a fake clock on every sleep."""
+
+    def __init__(self, snapshots: list[dict[str, FileRecord]]) -> None:
+        self.snapshots = snapshots
+        self.polls = 0
+        self.now = 0.0
+
+    def snapshot(self, previous: dict[str, FileRecord] | None) -> dict[str, FileRecord]:
+        _ = previous
+        index = min(self.polls, len(self.snapshots) - 1)
+        self.polls += 1
+        return self.snapshots[index]
+
+    def sleep(self, seconds: float) -> None:
+        self.now += seconds
+
+    def done(self) -> bool:
+        return self.polls >= len(self.snapshots) + 3
+
+
+def test_watch_changes_debounces_bursts_into_one_batch() -> None:
+    base = _snapshot(a="1", b="1")
+    script = _Script(
+        [
+            base,
+            base,
+            _snapshot(a="2", b="1"),
+            _snapshot(a="3", b="1"),
+            _snapshot(a="3", b="1", c="1"),
+            _snapshot(a="3", b="1", c="1"),
+            _snapshot(a="3", b="1", c="1"),
+            _snapshot(a="3", c="1"),
+        ]
+    )
+
+    batches = list(
+        watch_changes(
+            script.snapshot,
+            debounce_seconds=0.15,
+            poll_seconds=0.1,
+            should_stop=script.done,
+            sleep=script.sleep,
+            clock=lambda: script.now,
+        )
+    )
+
+    assert batches == [
+        WatchBatch(changed=("a.go", "c.go"), removed=()),
+        WatchBatch(changed=(), removed=("b.go",)),
+    ]
+
+
+def test_watch_changes_ignores_stat_only_changes() -> None:
+    base = _snapshot(a="1")
+    touched = {"a.go": FileRecord(path="a.go", size=1, mtime_ns=9, content_hash="1")}
+    script = _Script([base, touched, touched, touched])
+
+    batches = list(
+        watch_changes(
+            script.snapshot,
+            debounce_seconds=0.0,
+            poll_seconds=0.1,
+            should_stop=script.done,
+            sleep=script.sleep,
+            clock=lambda: script.now,
+        )
+    )
+
+    assert batches == []
@@ -0,0 +1,81 @@
+from __future__ import annotations
+
+import io
+import json
+from contextlib import redirect_stdout
+
+import pytest
+
+from repo_mcp.completion import COMPLETION_SHELLS, describe_parser, render_completion
+from repo_mcp.config import SYMBOL_KINDS
+from repo_mcp.server import build_arg_parser, main
+from repo_mcp.symbols import JSON_SCHEMA_DIALECT, render_export_schema
+
+
+def _run(argv: list[str]) -> str:
+    stream = io.StringIO()
+    with redirect_stdout(stream):
+        assert main(argv) == 0
+    return stream.getvalue()
+
+
+def test_flags_json_lists_root_and_subcommand_flags_with_types_and_defaults() -> None:
+    payload = json.loads(_run(["flags", "--json"]))
+
+    assert payload["name"] == "repo-mcp"
+    flags = {flag["name"]: flag for flag in payload["flags"]}
+    assert [flag["name"] for flag in payload["flags"]] == sorted(flags)
+    assert flags["--repo-root"]["type"] == "path"
+    assert flags["--repo-root"]["default"] == "."
+    assert flags["--concurrency"]["type"] == "integer"
+    assert flags["--exclude"]["repeatable"] is True
+    assert flags["--graph-level"]["choices"] == ["package", "file", "symbol"]
+    assert flags["--help"]["aliases"] == ["-h"]
+    assert all(kind in flags["--kinds"]["help"] for kind in SYMBOL_KINDS)
+    assert all(flag["help"] for flag in payload["flags"])
+    assert {flag["type"] for flag in flags.values()} == {
+        "boolean",
+        "choice",
+        "integer",
+        "path",
+        "string",
+    }
+    commands = {command["name"]: command for command in payload["commands"]}
+    assert sorted(commands) == ["repo-mcp completion", "repo-mcp flags", "repo-mcp schema"]
+    assert commands["repo-mcp completion"]["arguments"][0]["choices"] == list(COMPLETION_SHELLS)
+    assert [flag["name"] for flag in commands["repo-mcp flags"]["flags"]] == ["--help", "--json"]
+
+
+def test_flags_text_listing_names_every_command() -> None:
+    text = _run(["flags"])
+
+    assert text.startswith("repo-mcp\n")
+    assert "\nrepo-mcp completion\n" in text
+    assert "  --min-complexity N" in text
+
+
+@pytest.mark.parametrize("shell", COMPLETION_SHELLS)
+def test_completion_scripts_cover_every_command_and_flag(shell: str) -> None:
+    parser = build_arg_parser()
+    script = _run(["completion", shell])
+
+    assert script == render_completion(parser, shell)
+    spec = describe_parser(parser)
+    for command in (spec, *spec.commands):
+        for flag in command.flags:
+            option = flag.name if shell != "fish" else f"-l {flag.name[2:]}"
+            assert option in script
+    assert "completion" in script and "flags" in script
+    assert "bash fish zsh" in script
+
+
+def test_schema_command_prints_the_export_schema() -> None:
+    output = _run(["schema"])
+
+    assert output == render_export_schema()
+    assert json.loads(output)["$schema"] == JSON_SCHEMA_DIALECT
+
+
+def test_completion_rejects_unknown_shell() -> None:
+    with pytest.raises(ValueError, match="expected one of: bash, fish, zsh"):
+        render_completion(build_arg_parser(), "powershell")
@@ -14,6 +14,12 @@ EXPECTED_TOOLS = [
     "repo.build_context_bundle",
     "repo.references",
     "repo.find_definition",
+    "repo.export_symbols",
+    "repo.pack_symbols",
+    "repo.query_symbols",
+    "repo.search_symbols",
+    "repo.summary",
+    "repo.diff_symbols",
     "repo.refresh_index",
     "repo.audit_log",
 ]
@@ -38,7 +44,9 @@ def test_required_params_are_correct() -> None:
     assert TOOL_SCHEMAS["repo.open_file"]["inputSchema"]["required"] == ["path"]
     assert TOOL_SCHEMAS["repo.outline"]["inputSchema"]["required"] == ["path"]
     assert TOOL_SCHEMAS["repo.search"]["inputSchema"]["required"] == ["query"]
+    assert TOOL_SCHEMAS["repo.search_symbols"]["inputSchema"]["required"] == ["query"]
     assert TOOL_SCHEMAS["repo.references"]["inputSchema"]["required"] == ["symbol"]
+    assert TOOL_SCHEMAS["repo.pack_symbols"]["inputSchema"]["required"] == ["max_tokens"]
     assert TOOL_SCHEMAS["repo.build_context_bundle"]["inputSchema"]["required"] == [
         "prompt",
         "budget",